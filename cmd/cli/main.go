@@ -2,10 +2,18 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/url"
 	"os"
+	"os/exec"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/spf13/cobra"
 
@@ -15,12 +23,18 @@ import (
 	"github.com/linkedin-agent/internal/ai"
 	"github.com/linkedin-agent/internal/config"
 	"github.com/linkedin-agent/internal/linkedin"
+	"github.com/linkedin-agent/internal/media/providers"
 	"github.com/linkedin-agent/internal/media/unsplash"
 	"github.com/linkedin-agent/internal/models"
 	"github.com/linkedin-agent/internal/source"
 	"github.com/linkedin-agent/internal/source/custom"
+	"github.com/linkedin-agent/internal/source/hackernews"
+	"github.com/linkedin-agent/internal/source/newsapi"
+	"github.com/linkedin-agent/internal/source/reddit"
 	"github.com/linkedin-agent/internal/source/rss"
+	"github.com/linkedin-agent/internal/source/twitter"
 	"github.com/linkedin-agent/internal/storage"
+	"github.com/linkedin-agent/internal/storage/postgres"
 	"github.com/linkedin-agent/internal/storage/sheets"
 	"github.com/linkedin-agent/internal/storage/sqlite"
 	"github.com/linkedin-agent/internal/tracker"
@@ -35,6 +49,19 @@ var (
 	repo    storage.Repository
 )
 
+// AI usage reporting for --show-usage
+var (
+	showUsage bool
+	aiClients []*ai.Client
+)
+
+// Build info, set via -ldflags "-X main.version=... -X main.commit=... -X main.buildDate=..."
+var (
+	version   = "dev"
+	commit    = "none"
+	buildDate = "unknown"
+)
+
 func main() {
 	rootCmd := &cobra.Command{
 		Use:   "linkedin-agent",
@@ -42,6 +69,7 @@ func main() {
 		Long: `An autonomous agent that discovers trending topics and publishes
 engaging content to LinkedIn using Claude AI.`,
 		PersistentPreRunE: initializeApp,
+		PersistentPostRun: printAIUsage,
 	}
 
 	// Global flags
@@ -55,6 +83,11 @@ engaging content to LinkedIn using Claude AI.`,
 	rootCmd.AddCommand(postsCmd())
 	rootCmd.AddCommand(trackerCmd())
 	rootCmd.AddCommand(commentsCmd())
+	rootCmd.AddCommand(dbCmd())
+	rootCmd.AddCommand(migrateCmd())
+	rootCmd.AddCommand(sourcesCmd())
+	rootCmd.AddCommand(versionCmd())
+	rootCmd.AddCommand(doctorCmd())
 
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
@@ -62,6 +95,17 @@ engaging content to LinkedIn using Claude AI.`,
 }
 
 func initializeApp(cmd *cobra.Command, args []string) error {
+	if err := loadConfig(); err != nil {
+		return err
+	}
+
+	var err error
+	repo, err = openPrimaryRepository()
+	return err
+}
+
+// loadConfig loads the config file and sets up the logger
+func loadConfig() error {
 	var err error
 
 	// Load config
@@ -77,33 +121,113 @@ func initializeApp(cmd *cobra.Command, args []string) error {
 		Output: cfg.Logging.Output,
 	})
 
-	// Initialize storage based on configuration
-	// Use Google Sheets as database when tracker is enabled and has credentials
+	return nil
+}
+
+// openPrimaryRepository connects to the configured storage and runs its migrations.
+// Google Sheets is used as the database when the tracker is enabled and has credentials.
+func openPrimaryRepository() (storage.Repository, error) {
+	var r storage.Repository
+	var err error
+
 	if cfg.Tracker.Enabled && (cfg.Tracker.ServiceAccountJSON != "" || cfg.Tracker.CredentialsFile != "") {
 		log.Info().Msg("Using Google Sheets as primary storage")
-		repo, err = sheets.New(sheets.Config{
+		r, err = openBackend("sheets")
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		switch cfg.Database.Driver {
+		case "", "sqlite":
+			log.Info().Msg("Using SQLite as primary storage")
+			r, err = openBackend("sqlite")
+			if err != nil {
+				return nil, err
+			}
+		case "postgres":
+			log.Info().Msg("Using PostgreSQL as primary storage")
+			r, err = openBackend("postgres")
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unknown database driver %q (expected sqlite or postgres)", cfg.Database.Driver)
+		}
+	}
+
+	// Run migrations
+	if err := r.Migrate(); err != nil {
+		return nil, fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	return r, nil
+}
+
+// openBackend connects to a storage backend by name ("sqlite" or "sheets") without migrating it
+func openBackend(name string) (storage.Repository, error) {
+	switch name {
+	case "sqlite":
+		r, err := sqlite.New(cfg.Database.DSN)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to database: %w", err)
+		}
+		return r, nil
+	case "postgres":
+		r, err := postgres.New(cfg.Database.DSN)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to database: %w", err)
+		}
+		return r, nil
+	case "sheets":
+		if cfg.Tracker.ServiceAccountJSON == "" && cfg.Tracker.CredentialsFile == "" {
+			return nil, fmt.Errorf("tracker.service_account_json or tracker.credentials_file is required for Google Sheets")
+		}
+		r, err := sheets.New(sheets.Config{
 			SpreadsheetID:      cfg.Tracker.SpreadsheetID,
 			ServiceAccountJSON: cfg.Tracker.ServiceAccountJSON,
 			CredentialsFile:    cfg.Tracker.CredentialsFile,
 		}, log)
 		if err != nil {
-			return fmt.Errorf("failed to connect to Google Sheets: %w", err)
+			return nil, fmt.Errorf("failed to connect to Google Sheets: %w", err)
 		}
-	} else {
-		// Fall back to SQLite
-		log.Info().Msg("Using SQLite as primary storage")
-		repo, err = sqlite.New(cfg.Database.DSN)
+		return r, nil
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q (expected sqlite, postgres or sheets)", name)
+	}
+}
+
+// describeStorage names the primary storage backend of c for display. Postgres DSNs carry
+// the password, so only their host, port and database are shown.
+func describeStorage(c *config.Config) string {
+	if c.Tracker.Enabled && (c.Tracker.ServiceAccountJSON != "" || c.Tracker.CredentialsFile != "") {
+		return "google sheets (" + c.Tracker.SpreadsheetID + ")"
+	}
+	if c.Database.Driver == "postgres" {
+		return "postgres (" + redactPostgresDSN(c.Database.DSN) + ")"
+	}
+	return "sqlite (" + c.Database.DSN + ")"
+}
+
+// redactPostgresDSN keeps the host, port and database of a postgres:// URL or libpq
+// key=value connection string, dropping the user, password and other settings
+func redactPostgresDSN(dsn string) string {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		u, err := url.Parse(dsn)
 		if err != nil {
-			return fmt.Errorf("failed to connect to database: %w", err)
+			return "unparsable DSN"
 		}
+		return u.Host + u.Path
 	}
 
-	// Run migrations
-	if err := repo.Migrate(); err != nil {
-		return fmt.Errorf("failed to run migrations: %w", err)
+	var kept []string
+	for _, field := range strings.Fields(dsn) {
+		key, _, _ := strings.Cut(field, "=")
+		switch key {
+		case "host", "port", "dbname":
+			kept = append(kept, field)
+		}
 	}
-
-	return nil
+	return strings.Join(kept, " ")
 }
 
 // ============ DISCOVER COMMANDS ============
@@ -115,11 +239,14 @@ func discoverCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(discoverRunCmd())
+	cmd.AddCommand(discoverHealthCmd())
+	cmd.PersistentFlags().BoolVar(&showUsage, "show-usage", false, "Print Claude token usage and estimated cost when done")
 	return cmd
 }
 
 func discoverRunCmd() *cobra.Command {
 	var sourceName string
+	var dryRun bool
 
 	cmd := &cobra.Command{
 		Use:   "run",
@@ -131,34 +258,22 @@ func discoverRunCmd() *cobra.Command {
 			limiter := ratelimit.NewDefaultLimiter()
 
 			// Initialize AI client
-			aiClient := ai.NewClient(cfg.Anthropic, limiter, log)
+			aiClient := newAIClient(limiter)
 
-			// Initialize source manager
-			sourceManager := source.NewManager()
-
-			// Register RSS sources
-			if cfg.Sources.RSS.Enabled {
-				for _, src := range rss.NewMultiple(cfg.Sources.RSS, log) {
-					sourceManager.Register(src)
-				}
-			}
-
-			// Register custom source
-			if cfg.Sources.Custom.Enabled {
-				sourceManager.Register(custom.New(cfg.Sources.Custom, log))
-			}
+			// Register the configured sources
+			sourceManager := newSourceManager(limiter)
 
 			// Create discovery agent
-			agent := discovery.NewAgent(sourceManager, aiClient, repo, log)
+			agent := discovery.NewAgent(sourceManager, aiClient, repo, cfg.Discovery, log)
 
 			// Run discovery
 			var result *discovery.DiscoveryResult
 			var err error
 
 			if sourceName != "" {
-				result, err = agent.RunForSource(ctx, sourceName)
+				result, err = agent.RunForSource(ctx, sourceName, dryRun)
 			} else {
-				result, err = agent.Run(ctx)
+				result, err = agent.Run(ctx, dryRun)
 			}
 
 			if err != nil {
@@ -173,9 +288,35 @@ func discoverRunCmd() *cobra.Command {
 			fmt.Printf("Topics Skipped: %d\n", result.TopicsSkipped)
 			fmt.Printf("Duration:       %s\n", result.Duration)
 
-			if len(result.Errors) > 0 {
+			if result.DryRun {
+				fmt.Printf("\n=== Ranked Topics (dry run, not saved) ===\n\n")
+				for i, t := range result.Topics {
+					fmt.Printf("%2d. [%.0f] %s\n", i+1, t.AIScore, truncateStr(t.Title, 70))
+					fmt.Printf("    Source: %s (%s)\n", t.SourceName, t.SourceType)
+					if t.AIAnalysis != "" {
+						fmt.Printf("    Analysis: %s\n", truncateStr(t.AIAnalysis, 100))
+					}
+				}
+			}
+
+			var sourceErrs, otherErrs []error
+			for _, e := range result.Errors {
+				var srcErr *source.SourceError
+				if errors.As(e, &srcErr) {
+					sourceErrs = append(sourceErrs, e)
+				} else {
+					otherErrs = append(otherErrs, e)
+				}
+			}
+			if len(sourceErrs) > 0 {
+				fmt.Printf("\nFailed sources:\n")
+				for _, e := range sourceErrs {
+					fmt.Printf("  - %s\n", e)
+				}
+			}
+			if len(otherErrs) > 0 {
 				fmt.Printf("\nErrors:\n")
-				for _, e := range result.Errors {
+				for _, e := range otherErrs {
 					fmt.Printf("  - %s\n", e)
 				}
 			}
@@ -185,9 +326,60 @@ func discoverRunCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&sourceName, "source", "", "Run discovery for specific source only")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Fetch and rank topics without saving them")
 	return cmd
 }
 
+func discoverHealthCmd() *cobra.Command {
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "health",
+		Short: "Run the health check of every enabled source",
+		// Failed sources are reported in the list, not a usage problem
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			health := newSourceManager(ratelimit.NewDefaultLimiter()).HealthCheckAll(ctx)
+			if len(health) == 0 {
+				fmt.Println("No sources are enabled")
+				return nil
+			}
+
+			fmt.Printf("\n=== Source Health ===\n\n")
+			failed := 0
+			for _, name := range sortedSourceNames(health) {
+				if err := health[name]; err != nil {
+					fmt.Printf("FAIL  %s: %s\n", name, truncateStr(err.Error(), 100))
+					failed++
+					continue
+				}
+				fmt.Printf("OK    %s\n", name)
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d sources failed their health check", failed, len(health))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Second, "Time allowed for all health checks")
+	return cmd
+}
+
+// sortedSourceNames returns the source names of a health check result in order
+func sortedSourceNames(health map[string]error) []string {
+	names := make([]string, 0, len(health))
+	for name := range health {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // ============ PUBLISH COMMANDS ============
 
 func publishCmd() *cobra.Command {
@@ -197,10 +389,17 @@ func publishCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(publishGenerateCmd())
+	cmd.AddCommand(publishRegenerateCmd())
+	cmd.AddCommand(publishPreviewCmd())
 	cmd.AddCommand(publishDigestCmd())
+	cmd.AddCommand(publishWeeklyRecapCmd())
+	cmd.AddCommand(publishArticleCmd())
+	cmd.AddCommand(publishPreviewImageCmd())
 	cmd.AddCommand(publishNowCmd())
+	cmd.AddCommand(publishRetryCmd())
 	cmd.AddCommand(publishScheduleCmd())
 	cmd.AddCommand(publishApproveCmd())
+	cmd.PersistentFlags().BoolVar(&showUsage, "show-usage", false, "Print Claude token usage and estimated cost when done")
 	return cmd
 }
 
@@ -208,6 +407,9 @@ func publishGenerateCmd() *cobra.Command {
 	var topicID uint
 	var postType string
 	var preview bool
+	var template string
+	var voice string
+	var duration int
 
 	cmd := &cobra.Command{
 		Use:   "generate",
@@ -215,18 +417,32 @@ func publishGenerateCmd() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
+			pType := models.PostTypeText
+			switch postType {
+			case "poll":
+				pType = models.PostTypePoll
+			case "article":
+				pType = models.PostTypeArticle
+			}
+
+			if cmd.Flags().Changed("duration") {
+				if pType != models.PostTypePoll {
+					return fmt.Errorf("--duration only applies to --type poll")
+				}
+				cfg.Publishing.PollDurationDays = duration
+			}
+
 			limiter := ratelimit.NewDefaultLimiter()
-			aiClient := ai.NewClient(cfg.Anthropic, limiter, log)
+			aiClient := newAIClient(limiter)
 			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
-			linkedinClient := linkedin.NewClient(oauthManager, limiter, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
 
 			agent := publisher.NewAgent(aiClient, linkedinClient, repo, cfg.Publishing, log)
 
 			// Configure media support if enabled
-			if cfg.Media.Enabled && cfg.Media.UnsplashAPIKey != "" {
-				unsplashClient := unsplash.NewClient(cfg.Media.UnsplashAPIKey, log)
-				agent.SetMediaConfig(cfg.Media, unsplashClient)
-				log.Info().Msg("Media support enabled with Unsplash")
+			if provider := providers.New(cfg.Media, log); provider != nil {
+				agent.SetMediaConfig(cfg.Media, provider)
+				log.Info().Str("provider", cfg.Media.Provider).Msg("Media support enabled")
 			}
 
 			// Set up tracker if enabled
@@ -245,12 +461,7 @@ func publishGenerateCmd() *cobra.Command {
 				}
 			}
 
-			pType := models.PostTypeText
-			if postType == "poll" {
-				pType = models.PostTypePoll
-			}
-
-			result, err := agent.GenerateContent(ctx, topicID, pType)
+			result, err := agent.GenerateContent(ctx, topicID, pType, template, voice)
 			if err != nil {
 				return err
 			}
@@ -260,6 +471,25 @@ func publishGenerateCmd() *cobra.Command {
 			fmt.Printf("Status:  %s\n", result.Post.Status)
 			fmt.Printf("\n--- Preview ---\n%s\n", result.Preview)
 
+			if dupID, ok := result.Post.AIMetadata["near_duplicate_of"]; ok {
+				fmt.Printf("\nWarning: near-duplicate of post %v (similarity %.2f) - review before approving\n",
+					dupID, result.Post.AIMetadata["similarity"])
+			}
+
+			if claims, ok := result.Post.AIMetadata["flagged_claims"].([]string); ok {
+				fmt.Printf("\n--- Verify These Claims ---\n")
+				for _, claim := range claims {
+					fmt.Printf("  - %s\n", claim)
+				}
+			}
+
+			if pType == models.PostTypeArticle {
+				fmt.Printf("\n--- Feed Teaser ---\n%s\n", result.Post.Content)
+				fmt.Printf("\nPublish the article body on LinkedIn, then run 'publish article --post-id %d --url <article-url>'.\n",
+					result.Post.ID)
+				return nil
+			}
+
 			if !preview && result.Post.Status == models.PostStatusDraft {
 				fmt.Printf("\nPost saved as draft. Use 'publish approve %d' to schedule or 'publish now %d' to publish immediately.\n",
 					result.Post.ID, result.Post.ID)
@@ -270,74 +500,96 @@ func publishGenerateCmd() *cobra.Command {
 	}
 
 	cmd.Flags().UintVar(&topicID, "topic-id", 0, "Topic ID to generate content for (required)")
-	cmd.Flags().StringVar(&postType, "type", "text", "Post type: text or poll")
-	cmd.Flags().BoolVar(&preview, "preview", false, "Preview only, don't save")
+	cmd.Flags().StringVar(&postType, "type", "text", "Post type: text, poll or article")
+	cmd.Flags().BoolVar(&preview, "preview", false, "Skip the approve hint (the draft is still saved; use 'publish preview' to render without saving)")
+	cmd.Flags().StringVar(&template, "template", "", "Name of a post template from publishing.templates (text posts only)")
+	cmd.Flags().StringVar(&voice, "voice", "", "Name of a brand voice from publishing.voices (default: publishing.brand_voice)")
+	cmd.Flags().IntVar(&duration, "duration", 0, "Poll duration in days: 1, 3, 7 or 14 (polls only, overrides publishing.poll_duration_days)")
 	cmd.MarkFlagRequired("topic-id")
 
 	return cmd
 }
 
-func publishDigestCmd() *cobra.Command {
-	var minScore float64
+func publishPreviewCmd() *cobra.Command {
+	var topicID uint
+	var postType string
 
 	cmd := &cobra.Command{
-		Use:   "digest",
-		Short: "Generate a daily digest post from top 3 topics",
+		Use:   "preview",
+		Short: "Render the final post for a topic without saving a draft",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
 			limiter := ratelimit.NewDefaultLimiter()
-			aiClient := ai.NewClient(cfg.Anthropic, limiter, log)
+			aiClient := newAIClient(limiter)
+			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
 
-			// Create publisher agent to save the digest
-			oauthManager := linkedin.NewOAuthManagerEnvOnly(cfg.LinkedIn, log)
-			linkedinClient := linkedin.NewClient(oauthManager, limiter, log)
 			agent := publisher.NewAgent(aiClient, linkedinClient, repo, cfg.Publishing, log)
+			if provider := providers.New(cfg.Media, log); provider != nil {
+				agent.SetMediaConfig(cfg.Media, provider)
+			}
 
-			// Configure media support if enabled
-			if cfg.Media.Enabled && cfg.Media.UnsplashAPIKey != "" {
-				unsplashClient := unsplash.NewClient(cfg.Media.UnsplashAPIKey, log)
-				agent.SetMediaConfig(cfg.Media, unsplashClient)
-				log.Info().Msg("Media support enabled with Unsplash")
+			pType := models.PostTypeText
+			switch postType {
+			case "poll":
+				pType = models.PostTypePoll
+			case "article":
+				pType = models.PostTypeArticle
 			}
 
-			// Get top 3 topics to show what will be used
-			topics, err := repo.GetTopTopics(ctx, 3, minScore)
+			result, err := agent.PreviewContent(ctx, topicID, pType)
 			if err != nil {
-				return fmt.Errorf("failed to get topics: %w", err)
+				return err
 			}
+			post := result.Post
 
-			if len(topics) < 3 {
-				return fmt.Errorf("need at least 3 pending topics with score >= %.0f, found %d", minScore, len(topics))
-			}
+			fmt.Printf("\n=== Post Preview (not saved) ===\n")
+			fmt.Printf("%s\n", result.Preview)
+			fmt.Printf("\nCharacters: %d\n", len([]rune(post.Content)))
 
-			fmt.Println("Generating digest from top 3 topics:")
-			for i, t := range topics[:3] {
-				fmt.Printf("  [%d] %s (%s)\n", i+1, t.Title, t.SourceName)
+			if pType == models.PostTypePoll {
+				fmt.Printf("\n--- Poll ---\n")
+				fmt.Printf("Question: %v\n", post.PostFormat["question"])
+				if options, ok := post.PostFormat["options"].([]string); ok {
+					for i, option := range options {
+						fmt.Printf("  %d. %s\n", i+1, option)
+					}
+				}
+				fmt.Printf("Duration: %v\n", post.PostFormat["duration"])
 			}
-			fmt.Println()
 
-			// Generate and save digest using publisher agent
-			result, err := agent.GenerateDigest(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to generate digest: %w", err)
+			if post.MediaURL != "" {
+				fmt.Printf("\n--- Image ---\n")
+				fmt.Printf("URL:         %s\n", post.MediaURL)
+				if attribution, _ := post.AIMetadata["image_attribution"].(string); attribution != "" {
+					fmt.Printf("Attribution: %s\n", attribution)
+				}
 			}
 
-			fmt.Printf("=== Daily Digest (Post #%d) ===\n\n%s\n", result.Post.ID, result.Preview)
+			if dupID, ok := post.AIMetadata["near_duplicate_of"]; ok {
+				fmt.Printf("\nWarning: near-duplicate of post %v (similarity %.2f)\n",
+					dupID, post.AIMetadata["similarity"])
+			}
 
+			fmt.Printf("\nRun 'publish generate --topic-id %d' to save it as a draft.\n", topicID)
 			return nil
 		},
 	}
 
-	cmd.Flags().Float64Var(&minScore, "min-score", 70, "Minimum topic score")
+	cmd.Flags().UintVar(&topicID, "topic-id", 0, "Topic ID to preview content for (required)")
+	cmd.Flags().StringVar(&postType, "type", "text", "Post type: text, poll or article")
+	cmd.MarkFlagRequired("topic-id")
 
 	return cmd
 }
 
-func publishNowCmd() *cobra.Command {
+func publishRegenerateCmd() *cobra.Command {
+	var feedback string
+
 	cmd := &cobra.Command{
-		Use:   "now [post-id]",
-		Short: "Publish a post immediately",
+		Use:   "regenerate [post-id]",
+		Short: "Rewrite a draft following your feedback",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
@@ -348,19 +600,12 @@ func publishNowCmd() *cobra.Command {
 			}
 
 			limiter := ratelimit.NewDefaultLimiter()
-			aiClient := ai.NewClient(cfg.Anthropic, limiter, log)
+			aiClient := newAIClient(limiter)
 			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
-			linkedinClient := linkedin.NewClient(oauthManager, limiter, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
 
 			agent := publisher.NewAgent(aiClient, linkedinClient, repo, cfg.Publishing, log)
 
-			// Configure media support if enabled
-			if cfg.Media.Enabled && cfg.Media.UnsplashAPIKey != "" {
-				unsplashClient := unsplash.NewClient(cfg.Media.UnsplashAPIKey, log)
-				agent.SetMediaConfig(cfg.Media, unsplashClient)
-				log.Info().Msg("Media support enabled with Unsplash")
-			}
-
 			// Set up tracker if enabled
 			if cfg.Tracker.Enabled {
 				t, err := tracker.NewSheetsTracker(tracker.Config{
@@ -377,90 +622,116 @@ func publishNowCmd() *cobra.Command {
 				}
 			}
 
-			result, err := agent.Publish(ctx, uint(postID))
+			result, err := agent.RegenerateContent(ctx, uint(postID), feedback)
 			if err != nil {
 				return err
 			}
 
-			fmt.Printf("\n=== Publish Result ===\n")
-			fmt.Printf("Post ID:      %d\n", result.PostID)
-			fmt.Printf("Published:    %v\n", result.Published)
-			fmt.Printf("LinkedIn URN: %s\n", result.LinkedInURN)
+			fmt.Printf("\n=== Regenerated Content ===\n")
+			fmt.Printf("Post ID: %d\n", result.Post.ID)
+			fmt.Printf("\n--- Preview ---\n%s\n", result.Preview)
+
+			if dupID, ok := result.Post.AIMetadata["near_duplicate_of"]; ok {
+				fmt.Printf("\nWarning: near-duplicate of post %v (similarity %.2f) - review before approving\n",
+					dupID, result.Post.AIMetadata["similarity"])
+			}
+
+			if claims, ok := result.Post.AIMetadata["flagged_claims"].([]string); ok {
+				fmt.Printf("\n--- Verify These Claims ---\n")
+				for _, claim := range claims {
+					fmt.Printf("  - %s\n", claim)
+				}
+			}
 
 			return nil
 		},
 	}
 
+	cmd.Flags().StringVar(&feedback, "feedback", "", "What to change, e.g. \"make it punchier\" (required)")
+	cmd.MarkFlagRequired("feedback")
+
 	return cmd
 }
 
-func publishScheduleCmd() *cobra.Command {
-	var at string
+func publishDigestCmd() *cobra.Command {
+	var minScore float64
 
 	cmd := &cobra.Command{
-		Use:   "schedule [post-id]",
-		Short: "Schedule a post for later",
-		Args:  cobra.ExactArgs(1),
+		Use:   "digest",
+		Short: "Generate a daily digest post from top 3 topics",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
-			postID, err := strconv.ParseUint(args[0], 10, 64)
-			if err != nil {
-				return fmt.Errorf("invalid post ID: %w", err)
+			limiter := ratelimit.NewDefaultLimiter()
+			aiClient := newAIClient(limiter)
+
+			// Create publisher agent to save the digest
+			oauthManager := linkedin.NewOAuthManagerEnvOnly(cfg.LinkedIn, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
+			agent := publisher.NewAgent(aiClient, linkedinClient, repo, cfg.Publishing, log)
+
+			// Configure media support if enabled
+			if provider := providers.New(cfg.Media, log); provider != nil {
+				agent.SetMediaConfig(cfg.Media, provider)
+				log.Info().Str("provider", cfg.Media.Provider).Msg("Media support enabled")
 			}
 
-			scheduledTime, err := time.Parse("2006-01-02 15:04", at)
+			// Get top 3 topics to show what will be used
+			topics, err := repo.GetTopTopics(ctx, 30, minScore, publisher.DigestTopicStatuses)
 			if err != nil {
-				return fmt.Errorf("invalid time format, use: YYYY-MM-DD HH:MM")
+				return fmt.Errorf("failed to get topics: %w", err)
 			}
+			topics = publisher.RankByFreshness(topics, cfg.Publishing.FreshnessHalfLifeHours)
+			topics = publisher.CapTopicsPerSource(topics, 3, cfg.Publishing.MaxTopicsPerSourceInDigest)
 
-			limiter := ratelimit.NewDefaultLimiter()
-			aiClient := ai.NewClient(cfg.Anthropic, limiter, log)
-			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
-			linkedinClient := linkedin.NewClient(oauthManager, limiter, log)
+			if len(topics) < 3 {
+				return fmt.Errorf("need at least 3 pending or approved topics with score >= %.0f, found %d", minScore, len(topics))
+			}
 
-			agent := publisher.NewAgent(aiClient, linkedinClient, repo, cfg.Publishing, log)
+			fmt.Println("Generating digest from top 3 topics:")
+			for i, t := range topics[:3] {
+				fmt.Printf("  [%d] %s (%s)\n", i+1, t.Title, t.SourceName)
+			}
+			fmt.Println()
 
-			if err := agent.SchedulePost(ctx, uint(postID), scheduledTime); err != nil {
-				return err
+			// Generate and save digest using publisher agent
+			result, err := agent.GenerateDigest(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to generate digest: %w", err)
 			}
 
-			fmt.Printf("Post %d scheduled for %s\n", postID, scheduledTime.Format(time.RFC1123))
+			fmt.Printf("=== Daily Digest (Post #%d) ===\n\n%s\n", result.Post.ID, result.Preview)
+
 			return nil
 		},
 	}
 
-	cmd.Flags().StringVar(&at, "at", "", "Schedule time (YYYY-MM-DD HH:MM)")
-	cmd.MarkFlagRequired("at")
+	cmd.Flags().Float64Var(&minScore, "min-score", 70, "Minimum topic score")
 
 	return cmd
 }
 
-func publishApproveCmd() *cobra.Command {
+func publishWeeklyRecapCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "approve [post-id]",
-		Short: "Approve a draft post for publishing",
-		Args:  cobra.ExactArgs(1),
+		Use:   "weekly-recap",
+		Short: "Generate a \"week in tech\" recap from the past 7 days of published posts",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
-			postID, err := strconv.ParseUint(args[0], 10, 64)
-			if err != nil {
-				return fmt.Errorf("invalid post ID: %w", err)
-			}
-
 			limiter := ratelimit.NewDefaultLimiter()
-			aiClient := ai.NewClient(cfg.Anthropic, limiter, log)
-			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
-			linkedinClient := linkedin.NewClient(oauthManager, limiter, log)
-
+			aiClient := newAIClient(limiter)
+			oauthManager := linkedin.NewOAuthManagerEnvOnly(cfg.LinkedIn, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
 			agent := publisher.NewAgent(aiClient, linkedinClient, repo, cfg.Publishing, log)
 
-			if err := agent.ApprovePost(ctx, uint(postID)); err != nil {
-				return err
+			result, err := agent.GenerateWeeklyRecap(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to generate weekly recap: %w", err)
 			}
 
-			fmt.Printf("Post %d approved and scheduled for immediate publishing\n", postID)
+			fmt.Printf("=== Weekly Recap (Post #%d) ===\n\n%s\n", result.Post.ID, result.Preview)
+			fmt.Printf("\nReview and schedule with: linkedin-agent publish schedule %d\n", result.Post.ID)
+
 			return nil
 		},
 	}
@@ -468,47 +739,361 @@ func publishApproveCmd() *cobra.Command {
 	return cmd
 }
 
-// ============ OAUTH COMMANDS ============
+func publishArticleCmd() *cobra.Command {
+	var topicIDs []uint
+	var postID uint
+	var articleURL string
 
-func oauthCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "oauth",
-		Short: "LinkedIn OAuth management",
-	}
+		Use:   "article",
+		Short: "Generate a long-form article from related topics, or set the URL of an article draft",
+		Long: `Generate a long-form article from a cluster of topics with --topic-ids.
 
-	cmd.AddCommand(oauthLoginCmd())
-	cmd.AddCommand(oauthStatusCmd())
-	cmd.AddCommand(oauthExportCmd())
-	return cmd
-}
-
-func oauthLoginCmd() *cobra.Command {
-	var port int
-
-	cmd := &cobra.Command{
-		Use:   "login",
-		Short: "Start LinkedIn OAuth login flow",
+LinkedIn does not let apps create native articles, so publish the generated body in
+LinkedIn's article editor (or your blog), then attach its URL with --post-id and --url.
+Publishing the post then shares a teaser linking to the article.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
-			defer cancel()
+			ctx := context.Background()
 
+			limiter := ratelimit.NewDefaultLimiter()
+			aiClient := newAIClient(limiter)
 			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
+			agent := publisher.NewAgent(aiClient, linkedinClient, repo, cfg.Publishing, log)
 
-			fmt.Printf("Starting OAuth server on port %d...\n", port)
-			authURL, err := oauthManager.StartOAuthServer(ctx, port)
+			if postID != 0 {
+				if articleURL == "" {
+					return fmt.Errorf("--url is required with --post-id")
+				}
+				if err := agent.SetArticleURL(ctx, postID, articleURL); err != nil {
+					return err
+				}
+				fmt.Printf("Article URL set for post %d. Use 'publish approve %d' or 'publish now %d' to share it.\n",
+					postID, postID, postID)
+				return nil
+			}
+
+			if len(topicIDs) == 0 {
+				return fmt.Errorf("--topic-ids is required")
+			}
 
+			result, err := agent.GenerateArticle(ctx, topicIDs, articleURL)
 			if err != nil {
-				return fmt.Errorf("OAuth failed: %w", err)
+				return err
 			}
 
-			fmt.Printf("\nPlease open this URL in your browser:\n%s\n", authURL)
-			fmt.Println("\nAuthentication successful!")
+			fmt.Printf("\n=== Generated Article (Post #%d) ===\n\n%s\n", result.Post.ID, result.Preview)
+			fmt.Printf("\n--- Feed Teaser ---\n%s\n", result.Post.Content)
+
+			if articleURL == "" {
+				fmt.Printf("\nPublish the article body on LinkedIn, then run 'publish article --post-id %d --url <article-url>'.\n",
+					result.Post.ID)
+			}
 
 			return nil
 		},
 	}
 
-	cmd.Flags().IntVar(&port, "port", 8080, "Port for OAuth callback server")
+	cmd.Flags().UintSliceVar(&topicIDs, "topic-ids", nil, "Topic IDs to build the article from (comma-separated)")
+	cmd.Flags().UintVar(&postID, "post-id", 0, "Existing article draft to attach --url to")
+	cmd.Flags().StringVar(&articleURL, "url", "", "URL where the article body is published")
+
+	return cmd
+}
+
+func publishPreviewImageCmd() *cobra.Command {
+	var keyword string
+	var count int
+
+	cmd := &cobra.Command{
+		Use:   "preview-image [topic-id]",
+		Short: "Show images the configured provider would pick for a topic",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			provider := providers.New(cfg.Media, log)
+			if provider == nil {
+				return fmt.Errorf("no image provider: check media.enabled, media.provider and its settings")
+			}
+
+			topicID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid topic ID: %w", err)
+			}
+
+			topic, err := repo.GetTopicByID(ctx, uint(topicID))
+			if err != nil {
+				return fmt.Errorf("topic not found: %w", err)
+			}
+
+			queries := []string{keyword}
+			if keyword == "" {
+				limiter := ratelimit.NewDefaultLimiter()
+				aiClient := newAIClient(limiter)
+
+				keywords, err := aiClient.GenerateImageSearchKeywords(ctx, topic)
+				if err != nil {
+					return fmt.Errorf("failed to generate image keywords: %w", err)
+				}
+				queries = append([]string{keywords.Primary}, keywords.Keywords...)
+
+				fmt.Printf("\n=== Image Keywords ===\n")
+				fmt.Printf("Primary:   %s\n", keywords.Primary)
+				fmt.Printf("Keywords:  %v\n", keywords.Keywords)
+				fmt.Printf("Category:  %s\n", keywords.Category)
+				fmt.Printf("Reasoning: %s\n", keywords.Reasoning)
+			}
+
+			// Each pick goes through the same provider, keywords and filters as publishing
+			fmt.Printf("\n=== Picks for %v ===\n", queries)
+			for i := 0; i < count; i++ {
+				url, attribution, id, err := provider.GetBestImage(ctx, queries)
+				if err != nil {
+					return fmt.Errorf("failed to pick an image: %w", err)
+				}
+				fmt.Printf("\n[%d] %s\n", i+1, id)
+				if attribution != "" {
+					fmt.Printf("    %s\n", attribution)
+				}
+				fmt.Printf("    URL: %s\n", url)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&keyword, "keyword", "", "Search with this keyword instead of AI-generated keywords")
+	cmd.Flags().IntVar(&count, "count", 3, "Number of picks to show")
+
+	return cmd
+}
+
+func publishNowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "now [post-id]",
+		Short: "Publish a post immediately",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			postID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid post ID: %w", err)
+			}
+
+			limiter := ratelimit.NewDefaultLimiter()
+			aiClient := newAIClient(limiter)
+			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
+
+			agent := publisher.NewAgent(aiClient, linkedinClient, repo, cfg.Publishing, log)
+
+			// Configure media support if enabled
+			if provider := providers.New(cfg.Media, log); provider != nil {
+				agent.SetMediaConfig(cfg.Media, provider)
+				log.Info().Str("provider", cfg.Media.Provider).Msg("Media support enabled")
+			}
+
+			// Set up tracker if enabled
+			if cfg.Tracker.Enabled {
+				t, err := tracker.NewSheetsTracker(tracker.Config{
+					Enabled:            cfg.Tracker.Enabled,
+					SpreadsheetID:      cfg.Tracker.SpreadsheetID,
+					SheetName:          cfg.Tracker.SheetName,
+					CredentialsFile:    cfg.Tracker.CredentialsFile,
+					ServiceAccountJSON: cfg.Tracker.ServiceAccountJSON,
+				}, log)
+				if err != nil {
+					log.Warn().Err(err).Msg("Failed to create tracker")
+				} else if t != nil {
+					agent.SetTracker(t)
+				}
+			}
+
+			result, err := agent.Publish(ctx, uint(postID))
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("\n=== Publish Result ===\n")
+			fmt.Printf("Post ID:      %d\n", result.PostID)
+			fmt.Printf("Published:    %v\n", result.Published)
+			fmt.Printf("LinkedIn URN: %s\n", result.LinkedInURN)
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func publishRetryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry [post-id]",
+		Short: "Retry publishing a failed post (max 3 attempts)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			postID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid post ID: %w", err)
+			}
+
+			limiter := ratelimit.NewDefaultLimiter()
+			aiClient := newAIClient(limiter)
+			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
+
+			agent := publisher.NewAgent(aiClient, linkedinClient, repo, cfg.Publishing, log)
+
+			// Configure media support if enabled
+			if provider := providers.New(cfg.Media, log); provider != nil {
+				agent.SetMediaConfig(cfg.Media, provider)
+			}
+
+			result, err := agent.RetryPost(ctx, uint(postID))
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("\n=== Retry Result ===\n")
+			fmt.Printf("Post ID:      %d\n", result.PostID)
+			fmt.Printf("Published:    %v\n", result.Published)
+			fmt.Printf("LinkedIn URN: %s\n", result.LinkedInURN)
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func publishScheduleCmd() *cobra.Command {
+	var at string
+
+	cmd := &cobra.Command{
+		Use:   "schedule [post-id]",
+		Short: "Schedule a post for later",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			postID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid post ID: %w", err)
+			}
+
+			// Interpret the time in scheduler.timezone, like the publish windows
+			scheduledTime, err := time.ParseInLocation("2006-01-02 15:04", at, cfg.Scheduler.Location())
+			if err != nil {
+				return fmt.Errorf("invalid time format, use: YYYY-MM-DD HH:MM")
+			}
+
+			limiter := ratelimit.NewDefaultLimiter()
+			aiClient := newAIClient(limiter)
+			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
+
+			agent := publisher.NewAgent(aiClient, linkedinClient, repo, cfg.Publishing, log)
+			if err := agent.SetPublishWindows(cfg.Scheduler.PublishWindows(), cfg.Scheduler.Location()); err != nil {
+				return err
+			}
+
+			publishAt, err := agent.SchedulePost(ctx, uint(postID), scheduledTime)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Post %d scheduled for %s\n", postID, scheduledTime.Format(time.RFC1123))
+			if !publishAt.Equal(scheduledTime) {
+				fmt.Printf("Warning: that is outside the scheduler's publish windows (scheduler.publish_crons).\n")
+				fmt.Printf("It will be published at the next window: %s\n", publishAt.Format(time.RFC1123))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&at, "at", "", "Schedule time (YYYY-MM-DD HH:MM, in scheduler.timezone)")
+	cmd.MarkFlagRequired("at")
+
+	return cmd
+}
+
+func publishApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [post-id]",
+		Short: "Approve a draft post for publishing",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			postID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid post ID: %w", err)
+			}
+
+			limiter := ratelimit.NewDefaultLimiter()
+			aiClient := newAIClient(limiter)
+			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
+
+			agent := publisher.NewAgent(aiClient, linkedinClient, repo, cfg.Publishing, log)
+
+			if err := agent.ApprovePost(ctx, uint(postID)); err != nil {
+				return err
+			}
+
+			fmt.Printf("Post %d approved and scheduled for immediate publishing\n", postID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// ============ OAUTH COMMANDS ============
+
+func oauthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oauth",
+		Short: "LinkedIn OAuth management",
+	}
+
+	cmd.AddCommand(oauthLoginCmd())
+	cmd.AddCommand(oauthStatusCmd())
+	cmd.AddCommand(oauthExportCmd())
+	return cmd
+}
+
+func oauthLoginCmd() *cobra.Command {
+	var port int
+
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Start LinkedIn OAuth login flow",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+			defer cancel()
+
+			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
+
+			fmt.Printf("Starting OAuth server on port %d...\n", port)
+			authURL, err := oauthManager.StartOAuthServer(ctx, port)
+
+			if err != nil {
+				return fmt.Errorf("OAuth failed: %w", err)
+			}
+
+			fmt.Printf("\nPlease open this URL in your browser:\n%s\n", authURL)
+			fmt.Println("\nAuthentication successful!")
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&port, "port", 8080, "Port for OAuth callback server")
 	return cmd
 }
 
@@ -573,6 +1158,9 @@ func topicsCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(topicsListCmd())
+	cmd.AddCommand(topicsApproveCmd())
+	cmd.AddCommand(topicsRejectCmd())
+	cmd.AddCommand(topicsExportCmd())
 	return cmd
 }
 
@@ -625,21 +1213,141 @@ func topicsListCmd() *cobra.Command {
 	return cmd
 }
 
-// ============ POSTS COMMANDS ============
+func topicsApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [topic-id]",
+		Short: "Approve a topic for content generation and digests",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setTopicStatus(context.Background(), args[0], models.TopicStatusApproved)
+		},
+	}
 
-func postsCmd() *cobra.Command {
+	return cmd
+}
+
+func topicsRejectCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "posts",
-		Short: "List and manage posts",
+		Use:   "reject [topic-id]",
+		Short: "Reject a topic so it is not used for posts",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setTopicStatus(context.Background(), args[0], models.TopicStatusRejected)
+		},
 	}
 
-	cmd.AddCommand(postsListCmd())
-	cmd.AddCommand(postsQueueCmd())
 	return cmd
 }
 
-func postsListCmd() *cobra.Command {
-	var status string
+func topicsExportCmd() *cobra.Command {
+	var format, out, status string
+	var minScore float64
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export topics to a JSON or CSV file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			filter := storage.TopicFilter{Limit: limit, OrderBy: "id"}
+			if minScore > 0 {
+				filter.MinScore = &minScore
+			}
+			if status != "" {
+				s := models.TopicStatus(status)
+				filter.Status = &s
+			}
+
+			topics, err := repo.ListTopics(ctx, filter)
+			if err != nil {
+				return err
+			}
+
+			if out == "" {
+				out = "topics." + format
+			}
+			if err := writeExportFile(out, func(w io.Writer) error {
+				return storage.WriteTopics(w, format, topics)
+			}); err != nil {
+				return err
+			}
+
+			fmt.Printf("Exported %d topics to %s\n", len(topics), out)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", storage.FormatJSON, "Output format (json, csv)")
+	cmd.Flags().StringVar(&out, "out", "", "Output file path (default topics.<format>)")
+	cmd.Flags().StringVar(&status, "status", "", "Filter by status (pending, approved, rejected, used)")
+	cmd.Flags().Float64Var(&minScore, "min-score", 0, "Minimum AI score")
+	cmd.Flags().IntVar(&limit, "limit", 0, "Maximum topics to export (0 = all)")
+
+	return cmd
+}
+
+// writeExportFile creates path and streams an export into it, removing the file if writing fails
+func writeExportFile(path string, write func(w io.Writer) error) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+		os.Remove(path)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// setTopicStatus moves a topic to the given status, refusing topics that were already used in a post
+func setTopicStatus(ctx context.Context, idArg string, status models.TopicStatus) error {
+	topicID, err := strconv.ParseUint(idArg, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid topic ID: %w", err)
+	}
+
+	topic, err := repo.GetTopicByID(ctx, uint(topicID))
+	if err != nil {
+		return fmt.Errorf("topic not found: %w", err)
+	}
+
+	if topic.Status == models.TopicStatusUsed {
+		return fmt.Errorf("topic %d has already been used in a post", topicID)
+	}
+
+	topic.Status = status
+	if err := repo.UpdateTopic(ctx, topic); err != nil {
+		return fmt.Errorf("failed to update topic: %w", err)
+	}
+
+	fmt.Printf("Topic %d %s: %s\n", topicID, status, topic.Title)
+	return nil
+}
+
+// ============ POSTS COMMANDS ============
+
+func postsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "posts",
+		Short: "List and manage posts",
+	}
+
+	cmd.AddCommand(postsListCmd())
+	cmd.AddCommand(postsQueueCmd())
+	cmd.AddCommand(postsStatsCmd())
+	cmd.AddCommand(postsEditCmd())
+	cmd.AddCommand(postsExportCmd())
+	return cmd
+}
+
+func postsListCmd() *cobra.Command {
+	var status string
 	var limit int
 
 	cmd := &cobra.Command{
@@ -691,6 +1399,49 @@ func postsListCmd() *cobra.Command {
 	return cmd
 }
 
+func postsExportCmd() *cobra.Command {
+	var format, out, status string
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export posts to a JSON or CSV file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			filter := storage.PostFilter{Limit: limit, OrderBy: "id"}
+			if status != "" {
+				s := models.PostStatus(status)
+				filter.Status = &s
+			}
+
+			posts, err := repo.ListPosts(ctx, filter)
+			if err != nil {
+				return err
+			}
+
+			if out == "" {
+				out = "posts." + format
+			}
+			if err := writeExportFile(out, func(w io.Writer) error {
+				return storage.WritePosts(w, format, posts)
+			}); err != nil {
+				return err
+			}
+
+			fmt.Printf("Exported %d posts to %s\n", len(posts), out)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", storage.FormatJSON, "Output format (json, csv)")
+	cmd.Flags().StringVar(&out, "out", "", "Output file path (default posts.<format>)")
+	cmd.Flags().StringVar(&status, "status", "", "Filter by status")
+	cmd.Flags().IntVar(&limit, "limit", 0, "Maximum posts to export (0 = all)")
+
+	return cmd
+}
+
 func postsQueueCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "queue",
@@ -731,6 +1482,148 @@ func postsQueueCmd() *cobra.Command {
 	return cmd
 }
 
+func postsStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats [post-id]",
+		Short: "Fetch a published post's engagement from LinkedIn",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			postID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid post ID: %w", err)
+			}
+
+			limiter := ratelimit.NewDefaultLimiter()
+			aiClient := newAIClient(limiter)
+			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
+
+			agent := publisher.NewAgent(aiClient, linkedinClient, repo, cfg.Publishing, log)
+
+			stats, err := agent.RefreshPostStats(ctx, uint(postID))
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("\n=== Post %d Stats ===\n", postID)
+			fmt.Printf("Likes:       %d\n", stats.Likes)
+			fmt.Printf("Comments:    %d\n", stats.Comments)
+			fmt.Printf("Shares:      %d\n", stats.Shares)
+			fmt.Printf("Impressions: %d\n", stats.Impressions)
+			if stats.Shares == 0 && stats.Impressions == 0 {
+				fmt.Println("(LinkedIn only reports shares and impressions for organization posts)")
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func postsEditCmd() *cobra.Command {
+	var contentFile string
+
+	cmd := &cobra.Command{
+		Use:   "edit [post-id]",
+		Short: "Edit a draft or scheduled post's content in $EDITOR",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			postID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid post ID: %w", err)
+			}
+
+			post, err := repo.GetPostByID(ctx, uint(postID))
+			if err != nil {
+				return fmt.Errorf("post not found: %w", err)
+			}
+			if post.Status != models.PostStatusDraft && post.Status != models.PostStatusScheduled {
+				return fmt.Errorf("post %d is %s, only draft and scheduled posts can be edited", postID, post.Status)
+			}
+
+			var content string
+			if contentFile != "" {
+				data, err := os.ReadFile(contentFile)
+				if err != nil {
+					return fmt.Errorf("failed to read content file: %w", err)
+				}
+				content = string(data)
+			} else {
+				content, err = editInEditor(post.Content)
+				if err != nil {
+					return err
+				}
+			}
+
+			content = strings.TrimSpace(content)
+			if content == "" {
+				return fmt.Errorf("content is empty, post not changed")
+			}
+			if content == post.Content {
+				fmt.Println("No changes made")
+				return nil
+			}
+
+			post.Content = content
+			if post.AIMetadata == nil {
+				post.AIMetadata = models.JSON{}
+			}
+			post.AIMetadata["edited_at"] = time.Now().Format(time.RFC3339)
+			if err := repo.UpdatePost(ctx, post); err != nil {
+				return fmt.Errorf("failed to save post: %w", err)
+			}
+
+			fmt.Printf("Post %d updated (%d characters)\n", postID, len(content))
+			if utf8.RuneCountInString(content) > cfg.Publishing.MaxCharacters {
+				fmt.Printf("Warning: content exceeds %d characters and will be truncated when published\n", cfg.Publishing.MaxCharacters)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&contentFile, "content-file", "", "Read the new content from this file instead of opening $EDITOR")
+	return cmd
+}
+
+// editInEditor opens content in $EDITOR (vi if unset) and returns the saved text
+func editInEditor(content string) (string, error) {
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{"vi"}
+	}
+
+	f, err := os.CreateTemp("", "linkedin-post-*.txt")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.WriteString(content); err != nil {
+		f.Close()
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+	f.Close()
+
+	editCmd := exec.Command(editor[0], append(editor[1:], f.Name())...)
+	editCmd.Stdin = os.Stdin
+	editCmd.Stdout = os.Stdout
+	editCmd.Stderr = os.Stderr
+	if err := editCmd.Run(); err != nil {
+		return "", fmt.Errorf("editor failed: %w", err)
+	}
+
+	data, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read edited content: %w", err)
+	}
+	return string(data), nil
+}
+
 // ============ TRACKER COMMANDS ============
 
 func trackerCmd() *cobra.Command {
@@ -743,6 +1636,7 @@ func trackerCmd() *cobra.Command {
 	cmd.AddCommand(trackerListCmd())
 	cmd.AddCommand(trackerAddCmd())
 	cmd.AddCommand(trackerSyncTopicsCmd())
+	cmd.AddCommand(trackerSyncBackCmd())
 	return cmd
 }
 
@@ -948,6 +1842,44 @@ This allows you to review all discovered topics and mark which ones to use for p
 	}
 }
 
+func trackerSyncBackCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sync-back",
+		Short: "Approve topics marked \"yes\" in the sheet's \"Use for Post?\" column",
+		Long: `Reads your decisions from the "Topics" sheet in Google Sheets and approves the
+database topics whose "Use for Post?" cell is yes, so they are picked for posts and digests.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if !cfg.Tracker.Enabled {
+				return fmt.Errorf("tracker is not enabled in config")
+			}
+
+			t, err := tracker.NewSheetsTracker(tracker.Config{
+				Enabled:            cfg.Tracker.Enabled,
+				SpreadsheetID:      cfg.Tracker.SpreadsheetID,
+				SheetName:          cfg.Tracker.SheetName,
+				CredentialsFile:    cfg.Tracker.CredentialsFile,
+				ServiceAccountJSON: cfg.Tracker.ServiceAccountJSON,
+			}, log)
+			if err != nil {
+				return fmt.Errorf("failed to create tracker: %w", err)
+			}
+
+			approved, skipped, err := t.ImportUserDecisions(ctx, repo)
+			if err != nil {
+				return fmt.Errorf("failed to import decisions: %w", err)
+			}
+
+			fmt.Printf("\nSync-back complete!\n")
+			fmt.Printf("  Approved: %d topics\n", approved)
+			fmt.Printf("  Skipped: %d (already approved/used or not found)\n", skipped)
+
+			return nil
+		},
+	}
+}
+
 // ============ COMMENTS COMMANDS ============
 
 func commentsCmd() *cobra.Command {
@@ -959,6 +1891,9 @@ func commentsCmd() *cobra.Command {
 	cmd.AddCommand(commentsListCmd())
 	cmd.AddCommand(commentsRunCmd())
 	cmd.AddCommand(commentsDiscoverCmd())
+	cmd.AddCommand(commentsGenerateCmd())
+	cmd.AddCommand(commentsPostCmd())
+	cmd.AddCommand(commentsExcludeCmd())
 	return cmd
 }
 
@@ -1016,18 +1951,25 @@ func commentsListCmd() *cobra.Command {
 }
 
 func commentsRunCmd() *cobra.Command {
+	var dryRun bool
+
 	cmd := &cobra.Command{
 		Use:   "run",
 		Short: "Run the comment automation (posts one comment if conditions are met)",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
+			commenterCfg := cfg.Commenter
+			if dryRun {
+				commenterCfg.DryRun = true
+			}
+
 			limiter := ratelimit.NewDefaultLimiter()
-			aiClient := ai.NewClient(cfg.Anthropic, limiter, log)
+			aiClient := newAIClient(limiter)
 			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
-			linkedinClient := linkedin.NewClient(oauthManager, limiter, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
 
-			agent := commenter.NewAgent(aiClient, linkedinClient, repo, cfg.Commenter, log)
+			agent := commenter.NewAgent(aiClient, linkedinClient, repo, commenterCfg, log)
 
 			result, err := agent.Run(ctx)
 			if err != nil {
@@ -1035,6 +1977,9 @@ func commentsRunCmd() *cobra.Command {
 			}
 
 			fmt.Printf("\n=== Comment Run Results ===\n")
+			if commenterCfg.DryRun {
+				fmt.Printf("Dry run: generated comments are saved as pending and were not posted\n")
+			}
 			fmt.Printf("Posts Discovered:   %d\n", result.PostsDiscovered)
 			fmt.Printf("Comments Generated: %d\n", result.CommentsGenerated)
 			fmt.Printf("Comments Posted:    %d\n", result.CommentsPosted)
@@ -1052,58 +1997,145 @@ func commentsRunCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Generate and save the comment as pending without posting it")
 	return cmd
 }
 
-func commentsDiscoverCmd() *cobra.Command {
+func commentsGenerateCmd() *cobra.Command {
+	var postURN, author, content string
+
 	cmd := &cobra.Command{
-		Use:   "discover",
-		Short: "Discover posts that could be commented on (dry run)",
+		Use:   "generate",
+		Short: "Generate a comment for a post and save it as pending for review",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
 
-			if len(cfg.Commenter.TargetInfluencers) == 0 {
-				fmt.Println("No target influencers configured.")
-				fmt.Println("Add LinkedIn profile URNs to commenter.target_influencers in your config.")
-				return nil
+			existing, err := repo.GetCommentByTargetURN(ctx, postURN)
+			if err != nil {
+				return fmt.Errorf("failed to check for an existing comment: %w", err)
+			}
+			if existing != nil {
+				return fmt.Errorf("post %s already has comment %d (%s)", postURN, existing.ID, existing.Status)
 			}
 
 			limiter := ratelimit.NewDefaultLimiter()
+			aiClient := newAIClient(limiter)
 			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
-			linkedinClient := linkedin.NewClient(oauthManager, limiter, log)
-
-			fmt.Printf("Discovering posts from %d influencer(s)...\n\n", len(cfg.Commenter.TargetInfluencers))
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
 
-			var allPosts []*linkedin.LinkedInPost
+			agent := commenter.NewAgent(aiClient, linkedinClient, repo, cfg.Commenter, log)
 
-			for _, influencerURN := range cfg.Commenter.TargetInfluencers {
-				posts, err := linkedinClient.GetPostsByAuthor(ctx, influencerURN, 5)
-				if err != nil {
-					fmt.Printf("  [ERROR] %s: %v\n", influencerURN, err)
-					continue
+			// The comment is saved by the agent, also when the quality gate rejects it
+			comment, err := agent.GenerateCommentPreview(ctx, postURN, author, content)
+			if err != nil {
+				if comment != nil {
+					fmt.Printf("Comment %d was saved as skipped:\n\n%s\n\n", comment.ID, comment.Content)
 				}
+				return err
+			}
 
-				for _, post := range posts {
-					engagement := post.LikeCount + post.CommentCount
+			fmt.Printf("\n=== Generated Comment ===\n")
+			fmt.Printf("ID:     %d\n", comment.ID)
+			fmt.Printf("Post:   %s\n", comment.TargetPostURN)
+			fmt.Printf("Style:  %s\n", comment.CommentStyle)
+			fmt.Printf("Status: %s\n\n", comment.Status)
+			fmt.Println(comment.Content)
+			fmt.Printf("\nTo publish: linkedin-agent comments post %d\n", comment.ID)
 
-					// Apply filters
-					if engagement < cfg.Commenter.MinPostEngagement {
-						continue
-					}
-					if cfg.Commenter.MaxPostEngagement > 0 && engagement > cfg.Commenter.MaxPostEngagement {
-						continue
-					}
+			return nil
+		},
+	}
 
-					allPosts = append(allPosts, post)
-				}
-			}
+	cmd.Flags().StringVar(&postURN, "post-urn", "", "URN of the post to comment on")
+	cmd.Flags().StringVar(&author, "author", "", "Display name of the post author")
+	cmd.Flags().StringVar(&content, "content", "", "Text of the post to comment on")
+	cmd.MarkFlagRequired("post-urn")
+	cmd.MarkFlagRequired("content")
 
-			if len(allPosts) == 0 {
-				fmt.Println("No eligible posts found matching your criteria.")
-				return nil
-			}
+	return cmd
+}
 
-			fmt.Printf("=== Eligible Posts (%d) ===\n\n", len(allPosts))
+func commentsPostCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "post [comment-id]",
+		Short: "Publish a pending comment to LinkedIn",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			commentID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid comment ID: %w", err)
+			}
+
+			limiter := ratelimit.NewDefaultLimiter()
+			aiClient := newAIClient(limiter)
+			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
+
+			agent := commenter.NewAgent(aiClient, linkedinClient, repo, cfg.Commenter, log)
+
+			if err := agent.PostComment(ctx, uint(commentID)); err != nil {
+				return err
+			}
+
+			fmt.Printf("Comment %d posted successfully\n", commentID)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func commentsDiscoverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "discover",
+		Short: "Discover posts that could be commented on (dry run)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if len(cfg.Commenter.TargetInfluencers) == 0 {
+				fmt.Println("No target influencers configured.")
+				fmt.Println("Add LinkedIn profile URNs to commenter.target_influencers in your config.")
+				return nil
+			}
+
+			limiter := ratelimit.NewDefaultLimiter()
+			oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
+			linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
+
+			fmt.Printf("Discovering posts from %d influencer(s)...\n\n", len(cfg.Commenter.TargetInfluencers))
+
+			var allPosts []*linkedin.LinkedInPost
+
+			for _, influencerURN := range cfg.Commenter.TargetInfluencers {
+				posts, err := linkedinClient.GetPostsByAuthor(ctx, influencerURN, 5)
+				if err != nil {
+					fmt.Printf("  [ERROR] %s: %v\n", influencerURN, err)
+					continue
+				}
+
+				for _, post := range posts {
+					engagement := post.LikeCount + post.CommentCount
+
+					// Apply filters
+					if engagement < cfg.Commenter.MinPostEngagement {
+						continue
+					}
+					if cfg.Commenter.MaxPostEngagement > 0 && engagement > cfg.Commenter.MaxPostEngagement {
+						continue
+					}
+
+					allPosts = append(allPosts, post)
+				}
+			}
+
+			if len(allPosts) == 0 {
+				fmt.Println("No eligible posts found matching your criteria.")
+				return nil
+			}
+
+			fmt.Printf("=== Eligible Posts (%d) ===\n\n", len(allPosts))
 			for i, post := range allPosts {
 				engagement := post.LikeCount + post.CommentCount
 				publishedAt := time.Unix(post.PublishedAt/1000, 0)
@@ -1123,8 +2155,10 @@ func commentsDiscoverCmd() *cobra.Command {
 				fmt.Printf("    Content: %s\n", truncateStr(post.Commentary, 150))
 
 				// Check if already commented
-				existing, _ := repo.GetCommentByTargetURN(ctx, post.URN)
-				if existing != nil {
+				existing, err := repo.GetCommentByTargetURN(ctx, post.URN)
+				if err != nil {
+					fmt.Printf("    [Failed to check for an existing comment: %v]\n", err)
+				} else if existing != nil {
 					fmt.Printf("    [Already commented]\n")
 				}
 				fmt.Println()
@@ -1137,6 +2171,515 @@ func commentsDiscoverCmd() *cobra.Command {
 	return cmd
 }
 
+func commentsExcludeCmd() *cobra.Command {
+	var reason string
+	var remove bool
+	var list bool
+
+	cmd := &cobra.Command{
+		Use:     "exclude [author]",
+		Aliases: []string{"disable-author"},
+		Short:   "Stop commenting on an author (URN or username) without editing config",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if list || len(args) == 0 {
+				authors, err := repo.ListExcludedAuthors(ctx)
+				if err != nil {
+					return err
+				}
+
+				fmt.Printf("\n=== Excluded Authors (%d) ===\n\n", len(authors)+len(cfg.Commenter.BlockedAuthors))
+				for _, a := range cfg.Commenter.BlockedAuthors {
+					fmt.Printf("  %s (config)\n", a)
+				}
+				for _, a := range authors {
+					fmt.Printf("  %s", a.Author)
+					if a.Reason != "" {
+						fmt.Printf(" - %s", a.Reason)
+					}
+					fmt.Printf(" (added %s)\n", a.CreatedAt.Format("2006-01-02"))
+				}
+				return nil
+			}
+
+			author := args[0]
+
+			if remove {
+				if err := repo.RemoveExcludedAuthor(ctx, author); err != nil {
+					return fmt.Errorf("failed to remove excluded author: %w", err)
+				}
+				fmt.Printf("Author %s removed from exclusion list\n", author)
+				return nil
+			}
+
+			if err := repo.AddExcludedAuthor(ctx, &models.ExcludedAuthor{
+				Author: author,
+				Reason: reason,
+			}); err != nil {
+				return fmt.Errorf("failed to exclude author: %w", err)
+			}
+
+			fmt.Printf("Author %s excluded. The commenter will skip their posts from now on.\n", author)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&reason, "reason", "", "Why this author is excluded")
+	cmd.Flags().BoolVar(&remove, "remove", false, "Remove the author from the exclusion list")
+	cmd.Flags().BoolVar(&list, "list", false, "List excluded authors")
+
+	return cmd
+}
+
+// ============ DB COMMANDS ============
+
+func dbCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "db",
+		Short: "Database backup and maintenance commands",
+	}
+
+	cmd.AddCommand(dbExportCmd())
+	cmd.AddCommand(dbImportCmd())
+	cmd.AddCommand(dbCheckCmd())
+	return cmd
+}
+
+func dbExportCmd() *cobra.Command {
+	var out string
+	var includeSecrets bool
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Back up topics, posts and comments (and optionally the OAuth token) to a JSON file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			backup, err := storage.Export(ctx, repo, includeSecrets)
+			if err != nil {
+				return err
+			}
+
+			data, err := json.MarshalIndent(backup, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to encode backup: %w", err)
+			}
+
+			if err := os.WriteFile(out, data, 0600); err != nil {
+				return fmt.Errorf("failed to write backup: %w", err)
+			}
+
+			fmt.Printf("\n=== Backup Exported ===\n")
+			fmt.Printf("File:     %s\n", out)
+			fmt.Printf("Topics:   %d\n", len(backup.Topics))
+			fmt.Printf("Posts:    %d\n", len(backup.Posts))
+			fmt.Printf("Comments: %d\n", len(backup.Comments))
+			fmt.Printf("Token:    %t\n", backup.Token != nil)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&out, "out", "backup.json", "Output file path")
+	cmd.Flags().BoolVar(&includeSecrets, "include-secrets", false, "Include the LinkedIn OAuth access/refresh tokens in the backup")
+
+	return cmd
+}
+
+func dbImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Restore a JSON backup created by 'db export'",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			data, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read backup: %w", err)
+			}
+
+			var backup storage.Backup
+			if err := json.Unmarshal(data, &backup); err != nil {
+				return fmt.Errorf("failed to parse backup: %w", err)
+			}
+
+			result := storage.Import(ctx, repo, &backup)
+
+			fmt.Printf("\n=== Backup Imported ===\n")
+			fmt.Printf("Topics:   %d imported, %d already present\n", result.TopicsImported, result.TopicsSkipped)
+			fmt.Printf("Posts:    %d imported, %d already present\n", result.PostsImported, result.PostsSkipped)
+			fmt.Printf("Comments: %d imported, %d already present\n", result.CommentsImported, result.CommentsSkipped)
+			fmt.Printf("Token:    %t\n", result.TokenImported)
+
+			if len(result.Errors) > 0 {
+				fmt.Printf("\nErrors:\n")
+				for _, e := range result.Errors {
+					fmt.Printf("  - %s\n", e)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func migrateCmd() *cobra.Command {
+	var from, to string
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Copy topics, posts and the OAuth token from one storage backend to another",
+		Long: `Copy topics, posts and the LinkedIn OAuth token between SQLite or PostgreSQL (database.dsn)
+and Google Sheets (tracker.spreadsheet_id and credentials), e.g. before moving a local setup to
+a headless deployment. IDs are kept unless the destination already uses them; topics and posts
+already in the destination are skipped, so the command can be re-run. Comments are not copied.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			if from == to {
+				return fmt.Errorf("--from and --to must be different backends")
+			}
+
+			src, err := openBackend(from)
+			if err != nil {
+				return err
+			}
+			defer src.Close()
+
+			dst, err := openBackend(to)
+			if err != nil {
+				return err
+			}
+			defer dst.Close()
+
+			for _, r := range []storage.Repository{src, dst} {
+				if err := r.Migrate(); err != nil {
+					return fmt.Errorf("failed to run migrations: %w", err)
+				}
+			}
+
+			result, err := storage.Copy(ctx, src, dst)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("\n=== Migrated %s -> %s ===\n", from, to)
+			fmt.Printf("Topics: %d copied (%d with new IDs), %d already present\n", result.TopicsCopied, result.TopicsRenumbered, result.TopicsSkipped)
+			fmt.Printf("Posts:  %d copied (%d with new IDs), %d already present\n", result.PostsCopied, result.PostsRenumbered, result.PostsSkipped)
+			fmt.Printf("Token:  %t\n", result.TokenCopied)
+
+			if len(result.Errors) > 0 {
+				fmt.Printf("\nErrors:\n")
+				for _, e := range result.Errors {
+					fmt.Printf("  - %s\n", e)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "sqlite", "Backend to copy from (sqlite, postgres, sheets)")
+	cmd.Flags().StringVar(&to, "to", "sheets", "Backend to copy to (sqlite, postgres, sheets)")
+
+	return cmd
+}
+
+func dbCheckCmd() *cobra.Command {
+	var fix bool
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Report posts referencing deleted topics and comments missing their target post",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			report, err := storage.CheckIntegrity(ctx, repo, fix)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("\n=== Integrity Check ===\n")
+			fmt.Printf("Posts checked:    %d\n", report.PostsChecked)
+			fmt.Printf("Comments checked: %d\n", report.CommentsChecked)
+
+			if len(report.OrphanedPosts) > 0 {
+				fmt.Printf("\nPosts with missing topics:\n")
+				for _, o := range report.OrphanedPosts {
+					fmt.Printf("  - post %d -> topic %d (%s)\n", o.Post.ID, o.TopicID, o.Post.Status)
+				}
+			}
+
+			if len(report.InvalidComments) > 0 {
+				fmt.Printf("\nComments without a target post:\n")
+				for _, c := range report.InvalidComments {
+					fmt.Printf("  - comment %d (%s)\n", c.ID, c.Status)
+				}
+			}
+
+			if len(report.OrphanedPosts) == 0 && len(report.InvalidComments) == 0 {
+				fmt.Println("\nNo problems found.")
+			} else if fix {
+				fmt.Printf("\nFixed: %d\n", report.Fixed)
+			} else {
+				fmt.Println("\nRun with --fix to clear the invalid references.")
+			}
+
+			if len(report.Errors) > 0 {
+				fmt.Printf("\nErrors:\n")
+				for _, e := range report.Errors {
+					fmt.Printf("  - %s\n", e)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&fix, "fix", false, "Clear dangling topic references and mark invalid comments as skipped")
+
+	return cmd
+}
+
+// ============ SOURCES COMMANDS ============
+
+func sourcesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sources",
+		Short: "Topic source utilities",
+	}
+
+	cmd.AddCommand(sourcesTestFeedCmd())
+	return cmd
+}
+
+func sourcesTestFeedCmd() *cobra.Command {
+	var sample int
+
+	cmd := &cobra.Command{
+		Use:   "test-feed [url]",
+		Short: "Fetch and parse a feed URL to verify it works before adding it to config",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			maxAge := rss.ParseMaxAge(cfg.Sources.RSS.MaxAge)
+			src := rss.New(config.RSSFeed{Name: "test-feed", URL: args[0]}, maxAge, log)
+			preview, err := src.Preview(ctx)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("\n=== Feed OK ===\n")
+			fmt.Printf("Title:        %s\n", preview.Title)
+			fmt.Printf("Format:       %s\n", preview.FeedType)
+			fmt.Printf("Items:        %d\n", preview.TotalItems)
+			fmt.Printf("Recent (%s): %d\n", maxAge, len(preview.RecentItems))
+
+			if len(preview.RecentItems) == 0 {
+				fmt.Printf("\nNo items from the last %s - discovery would find nothing in this feed.\n", maxAge)
+				return nil
+			}
+
+			fmt.Printf("\n--- Sample Items ---\n")
+			for i, item := range preview.RecentItems {
+				if i >= sample {
+					break
+				}
+				fmt.Printf("%s  %s\n", item.PublishedAt.Format("2006-01-02"), truncateStr(item.Title, 70))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&sample, "sample", 5, "Number of recent item titles to show")
+
+	return cmd
+}
+
+// ============ VERSION COMMAND ============
+
+func versionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "version",
+		Short: "Show build info and a summary of the active configuration",
+		// Only load config - no storage connection needed to report versions
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error { return nil },
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fmt.Printf("linkedin-agent %s (commit %s, built %s)\n", version, commit, buildDate)
+
+			loaded, err := config.Load(cfgFile)
+			if err != nil {
+				fmt.Printf("\nConfig: failed to load (%s)\n", err)
+				return nil
+			}
+
+			storageBackend := describeStorage(loaded)
+
+			var sources []string
+			if loaded.Sources.RSS.Enabled {
+				sources = append(sources, fmt.Sprintf("rss (%d feeds)", len(loaded.Sources.RSS.Feeds)))
+			}
+			if loaded.Sources.NewsAPI.Enabled && loaded.Sources.NewsAPI.APIKey != "" {
+				sources = append(sources, "newsapi")
+			}
+			if loaded.Sources.Reddit.Enabled && loaded.Sources.Reddit.ClientID != "" && loaded.Sources.Reddit.ClientSecret != "" {
+				sources = append(sources, fmt.Sprintf("reddit (%d subreddits)", len(loaded.Sources.Reddit.Subreddits)))
+			}
+			if loaded.Sources.Twitter.Enabled && loaded.Sources.Twitter.BearerToken != "" {
+				sources = append(sources, fmt.Sprintf("twitter (%d queries)", len(loaded.Sources.Twitter.SearchQueries)))
+			}
+			if loaded.Sources.HackerNews.Enabled {
+				sources = append(sources, "hackernews")
+			}
+			if loaded.Sources.Custom.Enabled {
+				sources = append(sources, "custom")
+			}
+			if len(sources) == 0 {
+				sources = append(sources, "none")
+			}
+
+			fmt.Printf("\n=== Configuration ===\n")
+			fmt.Printf("Storage:      %s\n", storageBackend)
+			fmt.Printf("Model:        %s\n", loaded.Anthropic.Model)
+			fmt.Printf("Sources:      %s\n", strings.Join(sources, ", "))
+			fmt.Printf("Tracker:      %t\n", loaded.Tracker.Enabled)
+			fmt.Printf("Media:        %t\n", loaded.Media.Enabled)
+			fmt.Printf("Commenter:    %t\n", loaded.Commenter.Enabled)
+			fmt.Printf("Auto-approve: %t\n", loaded.Publishing.AutoApprove)
+
+			return nil
+		},
+	}
+}
+
+// ============ DOCTOR COMMAND ============
+
+// doctorTimeout bounds each integration check so one hanging service doesn't stall the rest
+const doctorTimeout = 30 * time.Second
+
+// doctorCheck is one row of the doctor report
+type doctorCheck struct {
+	name   string
+	detail string
+	err    error
+}
+
+func doctorCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Check that every configured integration is reachable and its credentials work",
+		// Failed checks are reported in the table, not a usage problem
+		SilenceUsage: true,
+		// Storage is one of the checks, so only load config here
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error { return loadConfig() },
+		RunE: func(cmd *cobra.Command, args []string) error {
+			limiter := ratelimit.NewDefaultLimiter()
+			var checks []doctorCheck
+
+			run := func(name string, check func(ctx context.Context) (string, error)) {
+				ctx, cancel := context.WithTimeout(context.Background(), doctorTimeout)
+				defer cancel()
+				detail, err := check(ctx)
+				checks = append(checks, doctorCheck{name: name, detail: detail, err: err})
+			}
+
+			run("Storage", func(ctx context.Context) (string, error) {
+				r, err := openPrimaryRepository()
+				if err != nil {
+					return "", err
+				}
+				repo = r
+				return describeStorage(cfg), nil
+			})
+			if repo != nil {
+				defer repo.Close()
+			}
+
+			run("Anthropic", func(ctx context.Context) (string, error) {
+				if cfg.Anthropic.APIKey == "" {
+					return "", fmt.Errorf("anthropic.api_key is not set")
+				}
+				if _, err := newAIClient(limiter).Complete(ctx, "Reply with the single word OK.", "ping"); err != nil {
+					return "", err
+				}
+				return cfg.Anthropic.Model, nil
+			})
+
+			run("LinkedIn", func(ctx context.Context) (string, error) {
+				if repo == nil {
+					return "", fmt.Errorf("skipped, storage is unavailable")
+				}
+				oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
+				linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
+				profile, err := linkedinClient.GetProfile(ctx)
+				if err != nil {
+					return "", err
+				}
+				return "signed in as " + profile.Name, nil
+			})
+
+			if cfg.Tracker.Enabled {
+				run("Google Sheets", func(ctx context.Context) (string, error) {
+					sheetsRepo, err := openBackend("sheets")
+					if err != nil {
+						return "", err
+					}
+					defer sheetsRepo.Close()
+					if _, err := sheetsRepo.ListPosts(ctx, storage.PostFilter{Limit: 1}); err != nil {
+						return "", err
+					}
+					return cfg.Tracker.SpreadsheetID, nil
+				})
+			}
+
+			if cfg.Media.Enabled && (cfg.Media.Provider == "" || cfg.Media.Provider == "unsplash") {
+				run("Unsplash", func(ctx context.Context) (string, error) {
+					if cfg.Media.UnsplashAPIKey == "" {
+						return "", fmt.Errorf("media.unsplash_api_key is not set")
+					}
+					client := unsplash.NewClient(cfg.Media.UnsplashAPIKey, log, unsplash.WithBaseURL(cfg.Media.UnsplashBaseURL))
+					if _, err := client.SearchPhotos(ctx, "technology", 1); err != nil {
+						return "", err
+					}
+					return "api key accepted", nil
+				})
+			}
+
+			sourceCtx, cancel := context.WithTimeout(context.Background(), doctorTimeout)
+			sourceHealth := newSourceManager(limiter).HealthCheckAll(sourceCtx)
+			cancel()
+			for _, name := range sortedSourceNames(sourceHealth) {
+				checks = append(checks, doctorCheck{name: "Source " + name, detail: "reachable", err: sourceHealth[name]})
+			}
+
+			fmt.Printf("\n=== Doctor ===\n\n")
+			failed := 0
+			for _, c := range checks {
+				status, detail := "PASS", c.detail
+				if c.err != nil {
+					status, detail = "FAIL", c.err.Error()
+					failed++
+				}
+				fmt.Printf("%-30s %-4s  %s\n", truncateStr(c.name, 30), status, truncateStr(detail, 100))
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d of %d checks failed", failed, len(checks))
+			}
+			fmt.Printf("\nAll %d checks passed\n", len(checks))
+			return nil
+		},
+	}
+}
+
 // Helper function to truncate strings
 func truncateStr(s string, maxLen int) string {
 	if len(s) <= maxLen {
@@ -1155,3 +2698,96 @@ func formatDuration(d time.Duration) string {
 	}
 	return fmt.Sprintf("%.1f days", d.Hours()/24)
 }
+
+// Helper function to build a source manager with every enabled and configured topic source
+func newSourceManager(limiter *ratelimit.MultiLimiter) *source.Manager {
+	sourceManager := source.NewManager()
+	sourceManager.SetMaxConcurrency(cfg.Sources.MaxConcurrency)
+	sourceManager.SetFetchTimeout(cfg.Sources.FetchTimeoutDuration())
+
+	// Register RSS sources
+	if cfg.Sources.RSS.Enabled {
+		for _, src := range rss.NewMultiple(cfg.Sources.RSS, limiter, log) {
+			sourceManager.Register(src)
+		}
+	}
+
+	// Register NewsAPI source (requires an API key)
+	if cfg.Sources.NewsAPI.Enabled {
+		if cfg.Sources.NewsAPI.APIKey != "" {
+			sourceManager.Register(newsapi.New(cfg.Sources.NewsAPI, limiter, log))
+		} else {
+			log.Warn().Msg("NewsAPI source is enabled but sources.newsapi.api_key is not set, skipping")
+		}
+	}
+
+	// Register Reddit source (requires app credentials)
+	if cfg.Sources.Reddit.Enabled {
+		if cfg.Sources.Reddit.ClientID != "" && cfg.Sources.Reddit.ClientSecret != "" {
+			sourceManager.Register(reddit.New(cfg.Sources.Reddit, limiter, log))
+		} else {
+			log.Warn().Msg("Reddit source is enabled but sources.reddit.client_id/client_secret are not set, skipping")
+		}
+	}
+
+	// Register Twitter/X source (requires a bearer token)
+	if cfg.Sources.Twitter.Enabled {
+		if cfg.Sources.Twitter.BearerToken != "" {
+			sourceManager.Register(twitter.New(cfg.Sources.Twitter, limiter, log))
+		} else {
+			log.Warn().Msg("Twitter source is enabled but sources.twitter.bearer_token is not set, skipping")
+		}
+	}
+
+	// Register Hacker News source
+	if cfg.Sources.HackerNews.Enabled {
+		sourceManager.Register(hackernews.New(cfg.Sources.HackerNews, log))
+	}
+
+	// Register custom source
+	if cfg.Sources.Custom.Enabled {
+		sourceManager.Register(custom.New(cfg.Sources.Custom, log))
+	}
+
+	return sourceManager
+}
+
+// Helper function to create an AI client whose token usage is included in --show-usage
+func newAIClient(limiter *ratelimit.MultiLimiter) *ai.Client {
+	client := ai.NewClient(cfg.Anthropic, limiter, log)
+	if repo != nil {
+		client.SetUsageStore(repo)
+	}
+	aiClients = append(aiClients, client)
+	return client
+}
+
+// printAIUsage prints the Claude usage of the command's AI clients when --show-usage is set
+func printAIUsage(cmd *cobra.Command, args []string) {
+	if !showUsage {
+		return
+	}
+
+	var total ai.Usage
+	for _, client := range aiClients {
+		u := client.Usage()
+		total.Requests += u.Requests
+		total.InputTokens += u.InputTokens
+		total.OutputTokens += u.OutputTokens
+	}
+
+	fmt.Printf("\n=== Claude Usage ===\n")
+	fmt.Printf("Requests:      %d\n", total.Requests)
+	fmt.Printf("Input tokens:  %d\n", total.InputTokens)
+	fmt.Printf("Output tokens: %d\n", total.OutputTokens)
+	fmt.Printf("Est. cost:     $%.4f\n", total.Cost(cfg.Anthropic.InputPricePerMTok, cfg.Anthropic.OutputPricePerMTok))
+}
+
+// Helper function to build the LinkedIn client options from config
+func linkedinClientOptions() []linkedin.ClientOption {
+	return []linkedin.ClientOption{
+		linkedin.WithBaseURL(cfg.LinkedIn.APIBaseURL),
+		linkedin.WithMaxRetries(cfg.RateLimit.LinkedInMaxRetries),
+		linkedin.WithMaxCharacters(cfg.Publishing.MaxCharacters),
+	}
+}
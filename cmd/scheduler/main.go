@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -18,12 +19,18 @@ import (
 	"github.com/linkedin-agent/internal/ai"
 	"github.com/linkedin-agent/internal/config"
 	"github.com/linkedin-agent/internal/linkedin"
-	"github.com/linkedin-agent/internal/media/unsplash"
+	"github.com/linkedin-agent/internal/media/providers"
 	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/internal/notify"
 	"github.com/linkedin-agent/internal/source"
 	"github.com/linkedin-agent/internal/source/custom"
+	"github.com/linkedin-agent/internal/source/hackernews"
+	"github.com/linkedin-agent/internal/source/newsapi"
+	"github.com/linkedin-agent/internal/source/reddit"
 	"github.com/linkedin-agent/internal/source/rss"
+	"github.com/linkedin-agent/internal/source/twitter"
 	"github.com/linkedin-agent/internal/storage"
+	"github.com/linkedin-agent/internal/storage/postgres"
 	"github.com/linkedin-agent/internal/storage/sheets"
 	"github.com/linkedin-agent/internal/storage/sqlite"
 	"github.com/linkedin-agent/pkg/logger"
@@ -37,6 +44,13 @@ var (
 	repo    storage.Repository
 )
 
+// unusedTopicStatuses are the topic statuses the cleanup job may delete (everything but used)
+var unusedTopicStatuses = []models.TopicStatus{
+	models.TopicStatusPending,
+	models.TopicStatusApproved,
+	models.TopicStatusRejected,
+}
+
 func main() {
 	rootCmd := &cobra.Command{
 		Use:   "linkedin-scheduler",
@@ -84,11 +98,21 @@ func runScheduler(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("failed to connect to Google Sheets: %w", err)
 		}
 	} else {
-		// Fall back to SQLite
-		log.Info().Msg("Using SQLite as primary storage")
-		repo, err = sqlite.New(cfg.Database.DSN)
-		if err != nil {
-			return fmt.Errorf("failed to connect to database: %w", err)
+		switch cfg.Database.Driver {
+		case "", "sqlite":
+			log.Info().Msg("Using SQLite as primary storage")
+			repo, err = sqlite.New(cfg.Database.DSN)
+			if err != nil {
+				return fmt.Errorf("failed to connect to database: %w", err)
+			}
+		case "postgres":
+			log.Info().Msg("Using PostgreSQL as primary storage")
+			repo, err = postgres.New(cfg.Database.DSN)
+			if err != nil {
+				return fmt.Errorf("failed to connect to database: %w", err)
+			}
+		default:
+			return fmt.Errorf("unknown database driver %q (expected sqlite or postgres)", cfg.Database.Driver)
 		}
 	}
 	defer repo.Close()
@@ -105,31 +129,60 @@ func runScheduler(cmd *cobra.Command, args []string) error {
 
 	// Initialize AI client
 	aiClient := ai.NewClient(cfg.Anthropic, limiter, log)
+	aiClient.SetUsageStore(repo)
 
 	// Initialize source manager
 	sourceManager := source.NewManager()
+	sourceManager.SetMaxConcurrency(cfg.Sources.MaxConcurrency)
+	sourceManager.SetFetchTimeout(cfg.Sources.FetchTimeoutDuration())
 	if cfg.Sources.RSS.Enabled {
-		for _, src := range rss.NewMultiple(cfg.Sources.RSS, log) {
+		for _, src := range rss.NewMultiple(cfg.Sources.RSS, limiter, log) {
 			sourceManager.Register(src)
 		}
 	}
+	if cfg.Sources.NewsAPI.Enabled {
+		if cfg.Sources.NewsAPI.APIKey != "" {
+			sourceManager.Register(newsapi.New(cfg.Sources.NewsAPI, limiter, log))
+		} else {
+			log.Warn().Msg("NewsAPI source is enabled but sources.newsapi.api_key is not set, skipping")
+		}
+	}
+	if cfg.Sources.Reddit.Enabled {
+		if cfg.Sources.Reddit.ClientID != "" && cfg.Sources.Reddit.ClientSecret != "" {
+			sourceManager.Register(reddit.New(cfg.Sources.Reddit, limiter, log))
+		} else {
+			log.Warn().Msg("Reddit source is enabled but sources.reddit.client_id/client_secret are not set, skipping")
+		}
+	}
+	if cfg.Sources.Twitter.Enabled {
+		if cfg.Sources.Twitter.BearerToken != "" {
+			sourceManager.Register(twitter.New(cfg.Sources.Twitter, limiter, log))
+		} else {
+			log.Warn().Msg("Twitter source is enabled but sources.twitter.bearer_token is not set, skipping")
+		}
+	}
+	if cfg.Sources.HackerNews.Enabled {
+		sourceManager.Register(hackernews.New(cfg.Sources.HackerNews, log))
+	}
 	if cfg.Sources.Custom.Enabled {
 		sourceManager.Register(custom.New(cfg.Sources.Custom, log))
 	}
 
-	// Initialize LinkedIn client with env-only OAuth (tokens from env vars)
-	oauthManager := linkedin.NewOAuthManagerEnvOnly(cfg.LinkedIn, log)
-	linkedinClient := linkedin.NewClient(oauthManager, limiter, log)
+	// Initialize LinkedIn client (env var tokens take precedence over the stored token)
+	oauthManager := linkedin.NewOAuthManager(cfg.LinkedIn, repo, log)
+	linkedinClient := linkedin.NewClient(oauthManager, limiter, log, linkedinClientOptions()...)
 
 	// Create agents
-	discoveryAgent := discovery.NewAgent(sourceManager, aiClient, repo, log)
+	discoveryAgent := discovery.NewAgent(sourceManager, aiClient, repo, cfg.Discovery, log)
 	publisherAgent := publisher.NewAgent(aiClient, linkedinClient, repo, cfg.Publishing, log)
+	if err := publisherAgent.SetPublishWindows(cfg.Scheduler.PublishWindows(), cfg.Scheduler.Location()); err != nil {
+		return err
+	}
 
 	// Configure media support if enabled
-	if cfg.Media.Enabled && cfg.Media.UnsplashAPIKey != "" {
-		unsplashClient := unsplash.NewClient(cfg.Media.UnsplashAPIKey, log)
-		publisherAgent.SetMediaConfig(cfg.Media, unsplashClient)
-		log.Info().Msg("Media support enabled with Unsplash")
+	if provider := providers.New(cfg.Media, log); provider != nil {
+		publisherAgent.SetMediaConfig(cfg.Media, provider)
+		log.Info().Str("provider", cfg.Media.Provider).Msg("Media support enabled")
 	}
 
 	// Create commenter agent if enabled
@@ -139,15 +192,17 @@ func runScheduler(cmd *cobra.Command, args []string) error {
 		log.Info().Msg("Commenter agent enabled")
 	}
 
-	// Create cron scheduler
-	c := cron.New(cron.WithLogger(cronLogger{log}))
+	// Create cron scheduler (cron expressions are interpreted in scheduler.timezone)
+	c := cron.New(cron.WithLogger(cronLogger{log}), cron.WithLocation(cfg.Scheduler.Location()))
+	log.Info().Str("timezone", cfg.Scheduler.Location().String()).Msg("Scheduler time zone")
+	locks := newJobLocks()
 
 	// Schedule discovery job
-	_, err = c.AddFunc(cfg.Scheduler.DiscoveryCron, func() {
+	_, err = c.AddFunc(cfg.Scheduler.DiscoveryCron, locks.wrap("discovery", func() {
 		ctx := context.Background()
 		log.Info().Msg("Running scheduled discovery")
 
-		result, err := discoveryAgent.Run(ctx)
+		result, err := discoveryAgent.Run(ctx, false)
 		if err != nil {
 			log.Error().Err(err).Msg("Scheduled discovery failed")
 			return
@@ -157,14 +212,15 @@ func runScheduler(cmd *cobra.Command, args []string) error {
 			Int("topics_found", result.TopicsFound).
 			Int("topics_saved", result.TopicsSaved).
 			Msg("Scheduled discovery completed")
-	})
+		logAIUsage(aiClient)
+	}))
 	if err != nil {
 		return fmt.Errorf("failed to schedule discovery job: %w", err)
 	}
 	log.Info().Str("cron", cfg.Scheduler.DiscoveryCron).Msg("Discovery job scheduled")
 
 	// Schedule digest generation job (runs 5 minutes before publish)
-	_, err = c.AddFunc(cfg.Scheduler.DigestCron, func() {
+	_, err = c.AddFunc(cfg.Scheduler.DigestCron, locks.wrap("digest", func() {
 		ctx := context.Background()
 		log.Info().Msg("Running scheduled digest generation")
 
@@ -176,7 +232,7 @@ func runScheduler(cmd *cobra.Command, args []string) error {
 
 		// Always schedule the digest for publishing (scheduler = autonomous mode)
 		if result.Post.Status != models.PostStatusScheduled {
-			if err := publisherAgent.SchedulePost(ctx, result.Post.ID, time.Now()); err != nil {
+			if _, err := publisherAgent.SchedulePost(ctx, result.Post.ID, time.Now()); err != nil {
 				log.Error().Err(err).Msg("Failed to schedule digest for publishing")
 				return
 			}
@@ -185,63 +241,193 @@ func runScheduler(cmd *cobra.Command, args []string) error {
 		log.Info().
 			Uint("post_id", result.Post.ID).
 			Msg("Daily digest generated and scheduled")
-	})
+		logAIUsage(aiClient)
+	}))
 	if err != nil {
 		return fmt.Errorf("failed to schedule digest job: %w", err)
 	}
 	log.Info().Str("cron", cfg.Scheduler.DigestCron).Msg("Digest job scheduled")
 
+	// Schedule weekly recap job if configured
+	if cfg.Scheduler.WeeklyRecapCron != "" {
+		_, err = c.AddFunc(cfg.Scheduler.WeeklyRecapCron, locks.wrap("weekly-recap", func() {
+			ctx := context.Background()
+			log.Info().Msg("Running scheduled weekly recap generation")
+
+			result, err := publisherAgent.GenerateWeeklyRecap(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("Scheduled weekly recap generation failed")
+				return
+			}
+
+			if _, err := publisherAgent.SchedulePost(ctx, result.Post.ID, time.Now()); err != nil {
+				log.Error().Err(err).Msg("Failed to schedule weekly recap for publishing")
+				return
+			}
+
+			log.Info().
+				Uint("post_id", result.Post.ID).
+				Msg("Weekly recap generated and scheduled")
+			logAIUsage(aiClient)
+		}))
+		if err != nil {
+			return fmt.Errorf("failed to schedule weekly recap job: %w", err)
+		}
+		log.Info().Str("cron", cfg.Scheduler.WeeklyRecapCron).Msg("Weekly recap job scheduled")
+	}
+
 	// Schedule publish jobs - support multiple windows or single cron
-	publishCrons := cfg.Scheduler.PublishCrons
-	if len(publishCrons) == 0 {
-		// Fallback to single publish_cron for backward compatibility
-		publishCrons = []string{cfg.Scheduler.PublishCron}
+	// (falls back to publish_cron for backward compatibility)
+	publishCrons := cfg.Scheduler.PublishWindows()
+
+	// publishDue publishes all due posts unless the daily limit has been reached
+	publishDue := func(ctx context.Context) (published int, todayCount int, errors []error, limitReached bool) {
+		// Check daily limit before publishing
+		todayCount, err := publisherAgent.GetTodayPublishCount(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to get today's publish count")
+		} else if todayCount >= publisherAgent.GetMaxPostsPerDay() {
+			log.Info().
+				Int("published_today", todayCount).
+				Int("max_per_day", publisherAgent.GetMaxPostsPerDay()).
+				Msg("Daily publish limit reached, skipping")
+			return 0, todayCount, nil, true
+		}
+
+		published, errors = publisherAgent.ProcessScheduledPosts(ctx)
+		if len(errors) > 0 {
+			for _, e := range errors {
+				log.Error().Err(e).Msg("Publish error")
+			}
+		}
+
+		return published, todayCount, errors, false
 	}
 
 	for i, publishCron := range publishCrons {
 		windowIndex := i
 		cronExpr := publishCron
-		_, err = c.AddFunc(cronExpr, func() {
+		_, err = c.AddFunc(cronExpr, locks.wrap("publish", func() {
 			ctx := context.Background()
 			log.Info().Int("window", windowIndex).Msg("Running scheduled publish")
 
-			// Check daily limit before publishing
-			todayCount, err := publisherAgent.GetTodayPublishCount(ctx)
-			if err != nil {
-				log.Error().Err(err).Msg("Failed to get today's publish count")
-			} else if todayCount >= publisherAgent.GetMaxPostsPerDay() {
-				log.Info().
-					Int("published_today", todayCount).
-					Int("max_per_day", publisherAgent.GetMaxPostsPerDay()).
-					Msg("Daily publish limit reached, skipping")
+			published, todayCount, errors, limitReached := publishDue(ctx)
+			if limitReached {
 				return
 			}
 
-			published, errors := publisherAgent.ProcessScheduledPosts(ctx)
-			if len(errors) > 0 {
-				for _, e := range errors {
-					log.Error().Err(e).Msg("Publish error")
-				}
-			}
-
 			log.Info().
 				Int("window", windowIndex).
 				Int("published", published).
 				Int("errors", len(errors)).
 				Int("today_total", todayCount+published).
 				Msg("Scheduled publish completed")
-		})
+		}))
 		if err != nil {
 			return fmt.Errorf("failed to schedule publish job %d: %w", windowIndex, err)
 		}
 		log.Info().Int("window", windowIndex).Str("cron", cronExpr).Msg("Publish job scheduled")
 	}
 
+	// Schedule cleanup job
+	if cfg.Scheduler.CleanupCron != "" {
+		_, err = c.AddFunc(cfg.Scheduler.CleanupCron, locks.wrap("cleanup", func() {
+			ctx := context.Background()
+			log.Info().Msg("Running scheduled cleanup")
+
+			// Each step runs even if an earlier one failed
+			deleted, err := publisherAgent.CleanupStaleDrafts(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("Stale draft cleanup failed")
+			}
+
+			topicsDeleted, failedDeleted := 0, 0
+			if cfg.Scheduler.CleanupMaxAgeDays > 0 {
+				cutoff := time.Now().AddDate(0, 0, -cfg.Scheduler.CleanupMaxAgeDays)
+
+				topicsDeleted, err = repo.DeleteTopicsOlderThan(ctx, cutoff, unusedTopicStatuses)
+				if err != nil {
+					log.Error().Err(err).Msg("Old topic cleanup failed")
+				}
+
+				failedDeleted, err = publisherAgent.PruneFailedPosts(ctx, cutoff)
+				if err != nil {
+					log.Error().Err(err).Msg("Failed post cleanup failed")
+				}
+			}
+
+			fixed := 0
+			if cfg.Scheduler.CleanupFixOrphans {
+				report, err := storage.CheckIntegrity(ctx, repo, true)
+				if err != nil {
+					log.Error().Err(err).Msg("Integrity check failed")
+				} else {
+					fixed = report.Fixed
+				}
+			}
+
+			log.Info().
+				Int("drafts_deleted", deleted).
+				Int("topics_deleted", topicsDeleted).
+				Int("failed_posts_deleted", failedDeleted).
+				Int("references_fixed", fixed).
+				Msg("Scheduled cleanup completed")
+		}))
+		if err != nil {
+			return fmt.Errorf("failed to schedule cleanup job: %w", err)
+		}
+		log.Info().Str("cron", cfg.Scheduler.CleanupCron).Msg("Cleanup job scheduled")
+	}
+
+	// Schedule poll results collection
+	if cfg.Scheduler.PollResultsCron != "" {
+		_, err = c.AddFunc(cfg.Scheduler.PollResultsCron, locks.wrap("poll-results", func() {
+			ctx := context.Background()
+			log.Debug().Msg("Running scheduled poll results collection")
+
+			collected, err := publisherAgent.CollectPollResults(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("Poll results collection failed")
+				return
+			}
+
+			if collected > 0 {
+				log.Info().Int("polls", collected).Msg("Poll results collected")
+			}
+		}))
+		if err != nil {
+			return fmt.Errorf("failed to schedule poll results job: %w", err)
+		}
+		log.Info().Str("cron", cfg.Scheduler.PollResultsCron).Msg("Poll results job scheduled")
+	}
+
+	// Schedule engagement sync
+	if cfg.Scheduler.AnalyticsCron != "" {
+		_, err = c.AddFunc(cfg.Scheduler.AnalyticsCron, locks.wrap("analytics", func() {
+			ctx := context.Background()
+			log.Debug().Msg("Running scheduled engagement sync")
+
+			synced, err := publisherAgent.SyncEngagement(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("Engagement sync failed")
+				return
+			}
+
+			if synced > 0 {
+				log.Info().Int("posts", synced).Msg("Post engagement synced")
+			}
+		}))
+		if err != nil {
+			return fmt.Errorf("failed to schedule analytics job: %w", err)
+		}
+		log.Info().Str("cron", cfg.Scheduler.AnalyticsCron).Msg("Analytics job scheduled")
+	}
+
 	// Schedule comment job if enabled
 	// Runs every 30 minutes - the agent decides internally if it should post
 	// based on active hours and time since last comment
 	if commenterAgent != nil {
-		_, err = c.AddFunc("*/30 * * * *", func() {
+		_, err = c.AddFunc("*/30 * * * *", locks.wrap("comments", func() {
 			ctx := context.Background()
 			log.Debug().Msg("Running scheduled comment check")
 
@@ -260,17 +446,50 @@ func runScheduler(cmd *cobra.Command, args []string) error {
 					Dur("duration", result.Duration).
 					Msg("Comment job completed")
 			}
-		})
+		}))
 		if err != nil {
 			return fmt.Errorf("failed to schedule comment job: %w", err)
 		}
 		log.Info().Str("cron", "*/30 * * * *").Msg("Comment job scheduled (agent controls timing)")
 	}
 
+	// Schedule OAuth token expiry check (daily, plus once at startup)
+	if cfg.LinkedIn.ExpiryWarningDays > 0 {
+		notifier := notify.New(cfg.Scheduler.NotifyWebhookURL, log)
+		checkToken := func() {
+			checkTokenExpiry(context.Background(), oauthManager, notifier, cfg.LinkedIn.ExpiryWarningDays)
+		}
+		_, err = c.AddFunc("0 9 * * *", locks.wrap("token-check", checkToken))
+		if err != nil {
+			return fmt.Errorf("failed to schedule token check job: %w", err)
+		}
+		log.Info().Int("warning_days", cfg.LinkedIn.ExpiryWarningDays).Msg("Token expiry check scheduled")
+		checkToken()
+	}
+
 	// Start scheduler
 	c.Start()
 	log.Info().Msg("Scheduler started")
 
+	// Publish posts that became due while the daemon was down, without waiting for the next window
+	if cfg.Scheduler.PublishOnStart {
+		go locks.wrap("publish", func() {
+			ctx := context.Background()
+			log.Info().Msg("Running startup publish")
+
+			published, todayCount, errors, limitReached := publishDue(ctx)
+			if limitReached {
+				return
+			}
+
+			log.Info().
+				Int("published", published).
+				Int("errors", len(errors)).
+				Int("today_total", todayCount+published).
+				Msg("Startup publish completed")
+		})()
+	}
+
 	// Wait for shutdown signal
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
@@ -282,6 +501,92 @@ func runScheduler(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// logAIUsage logs today's and the running Claude usage with an estimated cost
+func logAIUsage(aiClient *ai.Client) {
+	today, total := aiClient.UsageToday(), aiClient.Usage()
+	log.Info().
+		Int("requests_today", today.Requests).
+		Int64("tokens_today", today.InputTokens+today.OutputTokens).
+		Float64("cost_today_usd", today.Cost(cfg.Anthropic.InputPricePerMTok, cfg.Anthropic.OutputPricePerMTok)).
+		Int("requests_total", total.Requests).
+		Int64("tokens_total", total.InputTokens+total.OutputTokens).
+		Float64("cost_total_usd", total.Cost(cfg.Anthropic.InputPricePerMTok, cfg.Anthropic.OutputPricePerMTok)).
+		Msg("Claude usage")
+}
+
+// linkedinClientOptions builds the LinkedIn client options from config
+func linkedinClientOptions() []linkedin.ClientOption {
+	return []linkedin.ClientOption{
+		linkedin.WithBaseURL(cfg.LinkedIn.APIBaseURL),
+		linkedin.WithMaxRetries(cfg.RateLimit.LinkedInMaxRetries),
+		linkedin.WithMaxCharacters(cfg.Publishing.MaxCharacters),
+	}
+}
+
+// checkTokenExpiry warns, and notifies, when the LinkedIn token has expired or expires within
+// warningDays, so headless deployments get re-authenticated before publishing starts failing
+func checkTokenExpiry(ctx context.Context, oauthManager *linkedin.OAuthManager, notifier *notify.Notifier, warningDays int) {
+	valid, expiresAt, err := oauthManager.GetTokenStatus(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("LinkedIn token check failed - run 'oauth login' and update LINKEDIN_LINKEDIN_ACCESS_TOKEN")
+		notifier.Notify(ctx, fmt.Sprintf("LinkedIn token check failed: %v. Run 'oauth login' and update LINKEDIN_LINKEDIN_ACCESS_TOKEN.", err))
+		return
+	}
+
+	if !valid {
+		log.Error().
+			Time("expired_at", expiresAt).
+			Msg("LinkedIn token has expired - publishing will fail until you re-authenticate")
+		notifier.Notify(ctx, fmt.Sprintf("LinkedIn token expired on %s. Publishing will fail until you run 'oauth login'.", expiresAt.Format("Jan 2, 2006")))
+		return
+	}
+
+	remaining := time.Until(expiresAt)
+	if remaining <= time.Duration(warningDays)*24*time.Hour {
+		log.Warn().
+			Time("expires_at", expiresAt).
+			Float64("days_left", remaining.Hours()/24).
+			Msg("LinkedIn token expires soon - run 'oauth login' and update LINKEDIN_LINKEDIN_ACCESS_TOKEN")
+		notifier.Notify(ctx, fmt.Sprintf("LinkedIn token expires on %s (%.1f days left). Run 'oauth login' and update LINKEDIN_LINKEDIN_ACCESS_TOKEN.", expiresAt.Format("Jan 2, 2006"), remaining.Hours()/24))
+		return
+	}
+
+	log.Debug().
+		Time("expires_at", expiresAt).
+		Msg("LinkedIn token is valid")
+}
+
+// jobLocks prevents overlapping runs of the same job. Jobs sharing a name
+// (e.g. all publish windows) share a lock.
+type jobLocks struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func newJobLocks() *jobLocks {
+	return &jobLocks{locks: make(map[string]*sync.Mutex)}
+}
+
+// wrap returns a job that is skipped if the previous run of the same job is still in progress
+func (j *jobLocks) wrap(name string, job func()) func() {
+	j.mu.Lock()
+	lock, ok := j.locks[name]
+	if !ok {
+		lock = &sync.Mutex{}
+		j.locks[name] = lock
+	}
+	j.mu.Unlock()
+
+	return func() {
+		if !lock.TryLock() {
+			log.Warn().Str("job", name).Msg("Previous run still in progress, skipping")
+			return
+		}
+		defer lock.Unlock()
+		job()
+	}
+}
+
 // cronLogger adapts our logger for cron
 type cronLogger struct {
 	log *logger.Logger
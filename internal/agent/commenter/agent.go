@@ -2,9 +2,12 @@ package commenter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
+	"regexp"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/linkedin-agent/internal/ai"
@@ -21,9 +24,21 @@ type Agent struct {
 	linkedinClient *linkedin.Client
 	repository     storage.Repository
 	config         config.CommenterConfig
+	location       *time.Location // Time zone for active hours
+	keywords       []keywordPattern
 	log            *logger.Logger
 }
 
+// errCommentRejected is returned when a generated comment fails the quality gate and was
+// recorded as skipped instead of posted
+var errCommentRejected = errors.New("comment rejected by quality gate")
+
+// keywordPattern matches a preferred keyword as a whole word, case-insensitively
+type keywordPattern struct {
+	keyword string
+	re      *regexp.Regexp
+}
+
 // NewAgent creates a new commenter agent
 func NewAgent(
 	aiClient *ai.Client,
@@ -37,10 +52,28 @@ func NewAgent(
 		linkedinClient: linkedinClient,
 		repository:     repository,
 		config:         commenterConfig,
+		location:       commenterConfig.Location(),
+		keywords:       compileKeywords(commenterConfig.PreferKeywords),
 		log:            log.WithComponent("commenter"),
 	}
 }
 
+// compileKeywords builds whole-word matchers, so a short keyword like "AI" doesn't match "said"
+func compileKeywords(keywords []string) []keywordPattern {
+	var patterns []keywordPattern
+	for _, keyword := range keywords {
+		keyword = strings.TrimSpace(keyword)
+		if keyword == "" {
+			continue
+		}
+		patterns = append(patterns, keywordPattern{
+			keyword: keyword,
+			re:      regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(keyword) + `\b`),
+		})
+	}
+	return patterns
+}
+
 // CommentResult contains the result of a comment run
 type CommentResult struct {
 	PostsDiscovered  int
@@ -64,7 +97,8 @@ func (a *Agent) Run(ctx context.Context) (*CommentResult, error) {
 	// Check if within active hours
 	if !a.isWithinActiveHours() {
 		a.log.Info().
-			Int("current_hour", time.Now().Hour()).
+			Int("current_hour", time.Now().In(a.location).Hour()).
+			Str("timezone", a.location.String()).
 			Int("active_start", a.config.ActiveHoursStart).
 			Int("active_end", a.config.ActiveHoursEnd).
 			Msg("Outside active hours, skipping")
@@ -80,8 +114,8 @@ func (a *Agent) Run(ctx context.Context) (*CommentResult, error) {
 		return result, nil
 	}
 
-	// Check daily limit
-	todayCount, err := a.repository.GetTodayCommentCount(ctx)
+	// Check daily limit (the day starts at midnight in the commenter's time zone)
+	todayCount, err := a.repository.CountCommentsSince(ctx, a.startOfDay())
 	if err != nil {
 		a.log.Warn().Err(err).Msg("Failed to get today's comment count")
 	} else if todayCount >= a.config.MaxCommentsPerDay {
@@ -117,7 +151,12 @@ func (a *Agent) Run(ctx context.Context) (*CommentResult, error) {
 	// Only post ONE comment per run (timing controls handle frequency)
 	for _, post := range posts {
 		// Check if we already commented on this post
-		existing, _ := a.repository.GetCommentByTargetURN(ctx, post.URN)
+		existing, err := a.repository.GetCommentByTargetURN(ctx, post.URN)
+		if err != nil {
+			a.log.Warn().Err(err).Str("post_urn", post.URN).Msg("Failed to check for an existing comment, skipping")
+			result.CommentsSkipped++
+			continue
+		}
 		if existing != nil {
 			a.log.Debug().Str("post_urn", post.URN).Msg("Already commented on this post, skipping")
 			result.CommentsSkipped++
@@ -128,7 +167,13 @@ func (a *Agent) Run(ctx context.Context) (*CommentResult, error) {
 		style := a.getNextCommentStyle(ctx)
 
 		// Generate and post comment
-		if err := a.generateAndPostCommentWithStyle(ctx, post, style); err != nil {
+		err = a.generateAndPostCommentWithStyle(ctx, post, style)
+		if errors.Is(err, errCommentRejected) {
+			a.log.Info().Err(err).Str("post_urn", post.URN).Msg("Comment skipped by quality gate")
+			result.CommentsSkipped++
+			continue
+		}
+		if err != nil {
 			a.log.Warn().Err(err).Str("post_urn", post.URN).Msg("Failed to comment on post")
 			result.Errors = append(result.Errors, err)
 			result.CommentsSkipped++
@@ -136,7 +181,9 @@ func (a *Agent) Run(ctx context.Context) (*CommentResult, error) {
 		}
 
 		result.CommentsGenerated++
-		result.CommentsPosted++
+		if !a.config.DryRun {
+			result.CommentsPosted++
+		}
 
 		// Only post one comment per run
 		break
@@ -155,12 +202,56 @@ func (a *Agent) Run(ctx context.Context) (*CommentResult, error) {
 	return result, nil
 }
 
-// discoverPosts finds posts to comment on from target influencers
+// maxConnectionsToScan caps how many connections are checked for posts per run
+const maxConnectionsToScan = 25
+
+// discoverPosts finds posts to comment on from connections (when preferred) and target influencers
 func (a *Agent) discoverPosts(ctx context.Context) ([]*models.TargetPost, error) {
 	var allPosts []*models.TargetPost
+	seen := make(map[string]bool)
+
+	excluded := a.excludedAuthors(ctx)
+
+	// Fetch posts from the user's own network first
+	if a.config.PreferConnections {
+		connections, err := a.linkedinClient.GetConnections(ctx, maxConnectionsToScan)
+		if err != nil {
+			a.log.Warn().Err(err).Msg("prefer_connections is set but connections could not be fetched (needs partner API access), using target_influencers only")
+		} else if len(connections) == 0 {
+			a.log.Warn().Msg("prefer_connections is set but no connections were returned, using target_influencers only")
+		}
+
+		for _, connectionURN := range connections {
+			if excluded[connectionURN] {
+				continue
+			}
+
+			posts, err := a.linkedinClient.GetPostsByAuthor(ctx, connectionURN, 3)
+			if err != nil {
+				a.log.Debug().
+					Err(err).
+					Str("connection", connectionURN).
+					Msg("Failed to fetch posts from connection")
+				continue
+			}
+
+			for _, post := range posts {
+				if target := a.toTargetPost(post); target != nil && !seen[target.URN] {
+					seen[target.URN] = true
+					target.FromConnection = true
+					allPosts = append(allPosts, target)
+				}
+			}
+		}
+	}
 
 	// Fetch posts from target influencers
 	for _, influencer := range a.config.TargetInfluencers {
+		if excluded[influencer] {
+			a.log.Debug().Str("influencer", influencer).Msg("Skipping excluded author")
+			continue
+		}
+
 		// Resolve username/identifier to URN
 		influencerURN, err := a.linkedinClient.ResolveToURN(ctx, influencer)
 		if err != nil {
@@ -170,6 +261,10 @@ func (a *Agent) discoverPosts(ctx context.Context) ([]*models.TargetPost, error)
 				Msg("Failed to resolve influencer to URN, skipping")
 			continue
 		}
+		if excluded[influencerURN] {
+			a.log.Debug().Str("influencer", influencerURN).Msg("Skipping excluded author")
+			continue
+		}
 
 		posts, err := a.linkedinClient.GetPostsByAuthor(ctx, influencerURN, 5)
 		if err != nil {
@@ -181,49 +276,153 @@ func (a *Agent) discoverPosts(ctx context.Context) ([]*models.TargetPost, error)
 		}
 
 		for _, post := range posts {
-			// Filter by engagement threshold
-			engagement := post.LikeCount + post.CommentCount
-
-			// Skip posts with too little engagement
-			if engagement < a.config.MinPostEngagement {
-				a.log.Debug().
-					Str("post_urn", post.URN).
-					Int("engagement", engagement).
-					Int("min_required", a.config.MinPostEngagement).
-					Msg("Skipping post: engagement too low")
-				continue
-			}
-
-			// Skip mega-viral posts where comments get buried
-			if a.config.MaxPostEngagement > 0 && engagement > a.config.MaxPostEngagement {
-				a.log.Debug().
-					Str("post_urn", post.URN).
-					Int("engagement", engagement).
-					Int("max_allowed", a.config.MaxPostEngagement).
-					Msg("Skipping post: too viral, comment would get buried")
-				continue
+			if target := a.toTargetPost(post); target != nil && !seen[target.URN] {
+				seen[target.URN] = true
+				allPosts = append(allPosts, target)
 			}
-
-			allPosts = append(allPosts, &models.TargetPost{
-				URN:          post.URN,
-				AuthorURN:    post.Author,
-				AuthorName:   "", // Could be populated if we fetch profile
-				Content:      post.Commentary,
-				LikeCount:    post.LikeCount,
-				CommentCount: post.CommentCount,
-				PublishedAt:  time.Unix(post.PublishedAt/1000, 0),
-			})
 		}
 	}
 
+	matched := a.matchPreferredKeywords(allPosts)
+
 	a.log.Debug().
 		Int("influencers", len(a.config.TargetInfluencers)).
 		Int("posts_found", len(allPosts)).
+		Int("preferred_keyword_matches", matched).
 		Msg("Post discovery completed")
 
 	return allPosts, nil
 }
 
+// matchPreferredKeywords records the first commenter.prefer_keywords entry found in each
+// post's content and returns how many posts matched. This is a ranking preference only:
+// LinkedIn's API offers no post search to regular apps, so it can't discover new posts.
+func (a *Agent) matchPreferredKeywords(posts []*models.TargetPost) int {
+	matched := 0
+	for _, post := range posts {
+		for _, k := range a.keywords {
+			if k.re.MatchString(post.Content) {
+				post.MatchedKeyword = k.keyword
+				matched++
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// toTargetPost converts a LinkedIn post into a comment candidate, or returns nil
+// if its engagement is outside the configured range
+func (a *Agent) toTargetPost(post *linkedin.LinkedInPost) *models.TargetPost {
+	if keyword := a.blockedKeyword(post.Commentary); keyword != "" {
+		a.log.Debug().
+			Str("post_urn", post.URN).
+			Str("keyword", keyword).
+			Msg("Skipping post: contains a blocked keyword")
+		return nil
+	}
+
+	engagement := post.LikeCount + post.CommentCount
+
+	// Skip posts with too little engagement
+	if engagement < a.config.MinPostEngagement {
+		a.log.Debug().
+			Str("post_urn", post.URN).
+			Int("engagement", engagement).
+			Int("min_required", a.config.MinPostEngagement).
+			Msg("Skipping post: engagement too low")
+		return nil
+	}
+
+	// Skip mega-viral posts where comments get buried
+	if a.config.MaxPostEngagement > 0 && engagement > a.config.MaxPostEngagement {
+		a.log.Debug().
+			Str("post_urn", post.URN).
+			Int("engagement", engagement).
+			Int("max_allowed", a.config.MaxPostEngagement).
+			Msg("Skipping post: too viral, comment would get buried")
+		return nil
+	}
+
+	return &models.TargetPost{
+		URN:          post.URN,
+		AuthorURN:    post.Author,
+		AuthorName:   "", // Resolved only for the post actually commented on
+		Content:      post.Commentary,
+		LikeCount:    post.LikeCount,
+		CommentCount: post.CommentCount,
+		PublishedAt:  time.Unix(post.PublishedAt/1000, 0),
+	}
+}
+
+// resolveAuthorName fills in the display name of a person author, so the comment can
+// address them by name. Only called for the post being commented on, and lookups are
+// cached by the LinkedIn client, so a run costs at most one extra API call.
+func (a *Agent) resolveAuthorName(ctx context.Context, post *models.TargetPost) {
+	if !a.config.ResolveAuthorNames || post.AuthorName != "" || !strings.HasPrefix(post.AuthorURN, "urn:li:person:") {
+		return
+	}
+
+	name, err := a.linkedinClient.GetPersonName(ctx, post.AuthorURN)
+	if err != nil {
+		a.log.Debug().Err(err).Str("author", post.AuthorURN).Msg("Failed to resolve author name")
+		return
+	}
+	post.AuthorName = name
+}
+
+// rejectComment applies the heuristic quality gate to a generated comment and returns the
+// reason it must not be posted, or "" when it passes
+func (a *Agent) rejectComment(comment string) string {
+	lower := strings.ToLower(comment)
+	for _, phrase := range a.config.BannedPhrases {
+		if phrase = strings.TrimSpace(phrase); phrase != "" && strings.Contains(lower, strings.ToLower(phrase)) {
+			return fmt.Sprintf("contains banned phrase %q", phrase)
+		}
+	}
+	if !a.config.AllowLinks && (strings.Contains(lower, "http://") || strings.Contains(lower, "https://") || strings.Contains(lower, "www.")) {
+		return "contains a link (promotional)"
+	}
+	if len(strings.Fields(comment)) < minCommentWords {
+		return fmt.Sprintf("shorter than %d words", minCommentWords)
+	}
+	return ""
+}
+
+// minCommentWords is the shortest comment worth posting; anything shorter reads as generic
+const minCommentWords = 5
+
+// blockedKeyword returns the first commenter.blocked_keywords entry found in the content
+// (case-insensitive substring), or "" when the post is safe to comment on
+func (a *Agent) blockedKeyword(content string) string {
+	content = strings.ToLower(content)
+	for _, keyword := range a.config.BlockedKeywords {
+		if keyword = strings.TrimSpace(keyword); keyword != "" && strings.Contains(content, strings.ToLower(keyword)) {
+			return keyword
+		}
+	}
+	return ""
+}
+
+// excludedAuthors returns the set of authors to skip, from config and the persisted exclusion store
+func (a *Agent) excludedAuthors(ctx context.Context) map[string]bool {
+	excluded := make(map[string]bool)
+	for _, author := range a.config.BlockedAuthors {
+		excluded[author] = true
+	}
+
+	stored, err := a.repository.ListExcludedAuthors(ctx)
+	if err != nil {
+		a.log.Warn().Err(err).Msg("Failed to load excluded authors, using config only")
+		return excluded
+	}
+	for _, e := range stored {
+		excluded[e.Author] = true
+	}
+
+	return excluded
+}
+
 // generateAndPostComment creates and posts a comment on a target post (uses configured style)
 func (a *Agent) generateAndPostComment(ctx context.Context, post *models.TargetPost) error {
 	return a.generateAndPostCommentWithStyle(ctx, post, a.config.CommentStyle)
@@ -231,6 +430,57 @@ func (a *Agent) generateAndPostComment(ctx context.Context, post *models.TargetP
 
 // generateAndPostCommentWithStyle creates and posts a comment with a specific style
 func (a *Agent) generateAndPostCommentWithStyle(ctx context.Context, post *models.TargetPost, style string) error {
+	comment, err := a.prepareComment(ctx, post, style)
+	if err != nil {
+		return err
+	}
+
+	// Save to database first
+	if err := a.repository.CreateComment(ctx, comment); err != nil {
+		return fmt.Errorf("failed to save comment: %w", err)
+	}
+
+	if comment.Status == models.CommentStatusSkipped {
+		return fmt.Errorf("%w: %s", errCommentRejected, comment.ErrorMessage)
+	}
+
+	// In dry-run mode the comment stays pending for review instead of being posted. It still
+	// gets a next comment time so dry runs are spaced like real ones.
+	if a.config.DryRun {
+		comment.NextCommentAt = a.nextCommentTime(time.Now())
+		if err := a.repository.UpdateComment(ctx, comment); err != nil {
+			a.log.Warn().Err(err).Msg("Failed to update comment")
+		}
+		a.log.Info().
+			Str("post_urn", post.URN).
+			Uint("comment_id", comment.ID).
+			Str("style", style).
+			Str("comment", comment.Content).
+			Msg("Dry run: comment generated but not posted")
+		return nil
+	}
+
+	if err := a.publishComment(ctx, comment); err != nil {
+		return err
+	}
+
+	a.log.Info().
+		Str("post_urn", post.URN).
+		Str("comment_urn", comment.CommentURN).
+		Str("style", style).
+		Int("comment_length", len(comment.Content)).
+		Float64("engagement_velocity", post.EngagementVelocity).
+		Msg("Comment posted successfully")
+
+	return nil
+}
+
+// prepareComment generates a comment for post and runs it through quote verification,
+// the optional self-review and the quality gate. The returned record is not saved yet; it
+// is pending, or skipped with the reason in ErrorMessage when the gate rejects it.
+func (a *Agent) prepareComment(ctx context.Context, post *models.TargetPost, style string) (*models.Comment, error) {
+	a.resolveAuthorName(ctx, post)
+
 	// Truncate content for AI if too long
 	content := post.Content
 	if len(content) > 1000 {
@@ -238,16 +488,52 @@ func (a *Agent) generateAndPostCommentWithStyle(ctx context.Context, post *model
 	}
 
 	// Generate comment using AI
-	generated, err := a.aiClient.GenerateComment(ctx, post.AuthorName, content, style)
+	generated, err := a.aiClient.GenerateComment(ctx, post.AuthorName, content, style, a.config.QuoteTargetContent)
 	if err != nil {
-		return fmt.Errorf("failed to generate comment: %w", err)
+		return nil, fmt.Errorf("failed to generate comment: %w", err)
 	}
 
-	// Calculate engagement at time of comment
-	engagement := post.LikeCount + post.CommentCount
+	// Make sure the referenced snippet really comes from the post; retry once before giving up
+	if a.config.QuoteTargetContent && !ai.QuoteAppearsIn(generated.Quote, post.Content) {
+		a.log.Warn().
+			Str("post_urn", post.URN).
+			Str("quote", generated.Quote).
+			Msg("Quoted snippet not found in post, regenerating comment")
+
+		generated, err = a.aiClient.GenerateComment(ctx, post.AuthorName, content, style, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate comment: %w", err)
+		}
+		if !ai.QuoteAppearsIn(generated.Quote, post.Content) {
+			return nil, fmt.Errorf("generated comment quotes text not found in post %s", post.URN)
+		}
+	}
+
+	// Optional second pass: score the comment and rewrite it if generic or off-topic
+	reasoning := generated.Reasoning
+	rejection := ""
+	if a.config.SelfReview {
+		review, err := a.aiClient.ReviewComment(ctx, post.AuthorName, content, generated.Comment)
+		if err != nil {
+			a.log.Warn().Err(err).Str("post_urn", post.URN).Msg("Failed to self-review comment, using original")
+		} else if a.config.MinQuality > 0 && review.Score < a.config.MinQuality {
+			rejection = fmt.Sprintf("self-review score %.0f is below min_quality %.0f (%s)", review.Score, a.config.MinQuality, review.Feedback)
+		} else {
+			if review.RevisedComment != "" {
+				a.log.Info().
+					Str("post_urn", post.URN).
+					Float64("review_score", review.Score).
+					Msg("Comment rewritten after self-review")
+				generated.Comment = review.RevisedComment
+			}
+			reasoning = fmt.Sprintf("Self-review score: %.0f/100 (%s). %s", review.Score, review.Feedback, generated.Reasoning)
+		}
+	}
+
+	if rejection == "" {
+		rejection = a.rejectComment(generated.Comment)
+	}
 
-	// Create comment record with new fields
-	now := time.Now()
 	comment := &models.Comment{
 		TargetPostURN:    post.URN,
 		TargetAuthorURN:  post.AuthorURN,
@@ -256,17 +542,22 @@ func (a *Agent) generateAndPostCommentWithStyle(ctx context.Context, post *model
 		Content:          generated.Comment,
 		Status:           models.CommentStatusPending,
 		CommentStyle:     style,
-		AIReasoning:      generated.Reasoning,
-		PostEngagement:   engagement,
+		AIReasoning:      reasoning,
+		PostEngagement:   post.LikeCount + post.CommentCount, // Engagement at time of comment
 	}
 
-	// Save to database first
-	if err := a.repository.CreateComment(ctx, comment); err != nil {
-		return fmt.Errorf("failed to save comment: %w", err)
+	// Rejected comments are kept as skipped (with the reason) so the post isn't retried
+	if rejection != "" {
+		comment.Status = models.CommentStatusSkipped
+		comment.ErrorMessage = rejection
 	}
 
-	// Post to LinkedIn
-	commentURN, err := a.linkedinClient.CreateComment(ctx, post.URN, generated.Comment)
+	return comment, nil
+}
+
+// publishComment posts a saved comment to LinkedIn and records the outcome on it
+func (a *Agent) publishComment(ctx context.Context, comment *models.Comment) error {
+	commentURN, err := a.linkedinClient.CreateComment(ctx, comment.TargetPostURN, comment.Content)
 	if err != nil {
 		comment.Status = models.CommentStatusFailed
 		comment.ErrorMessage = err.Error()
@@ -274,80 +565,93 @@ func (a *Agent) generateAndPostCommentWithStyle(ctx context.Context, post *model
 		return fmt.Errorf("failed to post comment: %w", err)
 	}
 
-	// Update comment record with success
+	now := time.Now()
 	comment.Status = models.CommentStatusPosted
 	comment.CommentURN = commentURN
 	comment.PostedAt = &now
+	comment.NextCommentAt = a.nextCommentTime(now)
 	if err := a.repository.UpdateComment(ctx, comment); err != nil {
 		a.log.Warn().Err(err).Msg("Failed to update comment status")
 	}
-
-	a.log.Info().
-		Str("post_urn", post.URN).
-		Str("comment_urn", commentURN).
-		Str("style", style).
-		Int("comment_length", len(generated.Comment)).
-		Float64("engagement_velocity", post.EngagementVelocity).
-		Msg("Comment posted successfully")
-
 	return nil
 }
 
-// GenerateCommentPreview generates a comment without posting (for review)
+// GenerateCommentPreview generates a comment for review without posting it. The post and
+// comment get the same checks as in automatic runs (blocked keywords and authors, quote
+// verification, self-review and the quality gate). The comment is saved as pending, or as
+// skipped when the quality gate rejects it, in which case an error is returned too.
 func (a *Agent) GenerateCommentPreview(ctx context.Context, postURN, authorName, content string) (*models.Comment, error) {
-	generated, err := a.aiClient.GenerateComment(ctx, authorName, content, a.config.CommentStyle)
+	if keyword := a.blockedKeyword(content); keyword != "" {
+		return nil, fmt.Errorf("post contains blocked keyword %q", keyword)
+	}
+
+	authorURN, err := a.linkedinClient.GetPostAuthor(ctx, postURN)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate comment: %w", err)
+		return nil, fmt.Errorf("failed to look up post author: %w", err)
+	}
+	if a.excludedAuthors(ctx)[authorURN] {
+		return nil, fmt.Errorf("author %s is excluded from comments", authorURN)
 	}
 
-	comment := &models.Comment{
-		TargetPostURN:    postURN,
-		TargetAuthorName: authorName,
-		TargetPostTitle:  truncate(content, 100),
-		Content:          generated.Comment,
-		Status:           models.CommentStatusPending,
+	post := &models.TargetPost{URN: postURN, AuthorURN: authorURN, AuthorName: authorName, Content: content}
+	comment, err := a.prepareComment(ctx, post, a.config.CommentStyle)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.repository.CreateComment(ctx, comment); err != nil {
+		return nil, fmt.Errorf("failed to save comment: %w", err)
+	}
+	if comment.Status == models.CommentStatusSkipped {
+		return comment, fmt.Errorf("%w: %s", errCommentRejected, comment.ErrorMessage)
 	}
 
 	return comment, nil
 }
 
-// PostComment posts a previously generated comment
+// PostComment posts a pending comment. It is held to the same active hours, interval and
+// daily limit as automatic runs, and the author and comment are checked again in case the
+// exclusions or quality gate changed since it was generated.
 func (a *Agent) PostComment(ctx context.Context, commentID uint) error {
-	// Get comment from database
-	status := models.CommentStatusPending
-	comments, err := a.repository.ListComments(ctx, storage.CommentFilter{
-		Status: &status,
-		Limit:  100,
-	})
+	comment, err := a.repository.GetCommentByID(ctx, commentID)
 	if err != nil {
-		return fmt.Errorf("failed to list comments: %w", err)
+		return fmt.Errorf("failed to get comment %d: %w", commentID, err)
+	}
+	if comment.Status != models.CommentStatusPending {
+		return fmt.Errorf("comment %d is %s, only pending comments can be posted", commentID, comment.Status)
 	}
 
-	var comment *models.Comment
-	for _, c := range comments {
-		if c.ID == commentID {
-			comment = c
-			break
-		}
+	if !a.isWithinActiveHours() {
+		return fmt.Errorf("outside active hours (%d:00-%d:00 %s)", a.config.ActiveHoursStart, a.config.ActiveHoursEnd, a.location)
 	}
 
-	if comment == nil {
-		return fmt.Errorf("comment not found or not in pending status")
+	if last, err := a.repository.GetLastPostedComment(ctx); err == nil && last != nil && last.PostedAt != nil {
+		if wait := time.Until(a.nextAllowedAfter(last, *last.PostedAt)); wait > 0 {
+			return fmt.Errorf("too soon since the last comment, try again in %s", wait.Round(time.Minute))
+		}
 	}
 
-	// Post to LinkedIn
-	commentURN, err := a.linkedinClient.CreateComment(ctx, comment.TargetPostURN, comment.Content)
+	// Pending comments count toward the limit, so this one is already included if made today
+	midnight := a.startOfDay()
+	todayCount, err := a.repository.CountCommentsSince(ctx, midnight)
 	if err != nil {
-		comment.Status = models.CommentStatusFailed
-		comment.ErrorMessage = err.Error()
-		a.repository.UpdateComment(ctx, comment)
-		return fmt.Errorf("failed to post comment: %w", err)
+		return fmt.Errorf("failed to get today's comment count: %w", err)
+	}
+	if !comment.CreatedAt.Before(midnight) {
+		todayCount--
+	}
+	if todayCount >= a.config.MaxCommentsPerDay {
+		return fmt.Errorf("daily comment limit of %d reached", a.config.MaxCommentsPerDay)
 	}
 
-	// Update status
-	comment.Status = models.CommentStatusPosted
-	comment.CommentURN = commentURN
-	return a.repository.UpdateComment(ctx, comment)
+	if comment.TargetAuthorURN != "" && a.excludedAuthors(ctx)[comment.TargetAuthorURN] {
+		return fmt.Errorf("author %s is excluded from comments", comment.TargetAuthorURN)
+	}
+	if rejection := a.rejectComment(comment.Content); rejection != "" {
+		return fmt.Errorf("%w: %s", errCommentRejected, rejection)
+	}
+
+	return a.publishComment(ctx, comment)
 }
 
 func truncate(s string, maxLen int) string {
@@ -357,31 +661,63 @@ func truncate(s string, maxLen int) string {
 	return s[:maxLen-3] + "..."
 }
 
+// startOfDay returns midnight today in the commenter's time zone, when the daily limit resets
+func (a *Agent) startOfDay() time.Time {
+	now := time.Now().In(a.location)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, a.location)
+}
+
 // isWithinActiveHours checks if current time is within configured active hours
 func (a *Agent) isWithinActiveHours() bool {
-	hour := time.Now().Hour()
+	hour := time.Now().In(a.location).Hour()
 	return hour >= a.config.ActiveHoursStart && hour < a.config.ActiveHoursEnd
 }
 
-// canCommentNow checks if enough time has passed since the last comment
+// canCommentNow checks if the randomized interval chosen after the last comment has passed.
+// Pending comments (e.g. from dry runs) count from when they were generated.
 func (a *Agent) canCommentNow(ctx context.Context) (bool, time.Duration) {
-	lastComment, err := a.repository.GetLastCommentTime(ctx)
-	if err != nil || lastComment == nil {
-		// No previous comments, can comment now
-		return true, 0
+	var nextAllowed time.Time
+
+	if lastComment, err := a.repository.GetLastPostedComment(ctx); err == nil && lastComment != nil && lastComment.PostedAt != nil {
+		nextAllowed = a.nextAllowedAfter(lastComment, *lastComment.PostedAt)
 	}
 
-	minInterval := time.Duration(a.config.MinIntervalMinutes) * time.Minute
-	elapsed := time.Since(*lastComment)
+	pendingStatus := models.CommentStatusPending
+	pending, err := a.repository.ListComments(ctx, storage.CommentFilter{
+		Status:    &pendingStatus,
+		Limit:     1,
+		OrderBy:   "created_at",
+		OrderDesc: true,
+	})
+	if err == nil && len(pending) > 0 {
+		if next := a.nextAllowedAfter(pending[0], pending[0].CreatedAt); next.After(nextAllowed) {
+			nextAllowed = next
+		}
+	}
 
-	if elapsed >= minInterval {
-		return true, 0
+	if wait := time.Until(nextAllowed); wait > 0 {
+		return false, wait
 	}
+	return true, 0
+}
 
-	return false, minInterval - elapsed
+// nextAllowedAfter returns when the next comment may follow comment, made at madeAt.
+// Comments made before the interval was stored only have the fixed minimum.
+func (a *Agent) nextAllowedAfter(comment *models.Comment, madeAt time.Time) time.Time {
+	if comment.NextCommentAt != nil {
+		return *comment.NextCommentAt
+	}
+	return madeAt.Add(time.Duration(a.config.MinIntervalMinutes) * time.Minute)
+}
+
+// nextCommentTime picks the earliest time the next comment may be posted after one posted
+// at postedAt, so the gap between comments varies instead of following a fixed rhythm
+func (a *Agent) nextCommentTime(postedAt time.Time) *time.Time {
+	next := postedAt.Add(a.getRandomInterval())
+	return &next
 }
 
-// getRandomInterval returns a random interval between min and max configured values
+// getRandomInterval returns a random interval between min and max configured values (inclusive)
 func (a *Agent) getRandomInterval() time.Duration {
 	minMinutes := a.config.MinIntervalMinutes
 	maxMinutes := a.config.MaxIntervalMinutes
@@ -390,7 +726,7 @@ func (a *Agent) getRandomInterval() time.Duration {
 		return time.Duration(minMinutes) * time.Minute
 	}
 
-	randomMinutes := minMinutes + rand.Intn(maxMinutes-minMinutes)
+	randomMinutes := minMinutes + rand.Intn(maxMinutes-minMinutes+1)
 	return time.Duration(randomMinutes) * time.Minute
 }
 
@@ -464,8 +800,14 @@ func (a *Agent) rankPosts(posts []*models.TargetPost) []*models.TargetPost {
 		post.EngagementVelocity = float64(post.LikeCount+post.CommentCount*2) / hoursSincePost
 	}
 
-	// Sort by engagement velocity descending
+	// Sort by engagement velocity descending (keyword matches first, then connections when preferred)
 	sort.Slice(posts, func(i, j int) bool {
+		if iMatch, jMatch := posts[i].MatchedKeyword != "", posts[j].MatchedKeyword != ""; iMatch != jMatch {
+			return iMatch
+		}
+		if a.config.PreferConnections && posts[i].FromConnection != posts[j].FromConnection {
+			return posts[i].FromConnection
+		}
 		return posts[i].EngagementVelocity > posts[j].EngagementVelocity
 	})
 
@@ -0,0 +1,104 @@
+package commenter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkedin-agent/internal/config"
+	"github.com/linkedin-agent/internal/linkedin"
+	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/internal/storage/sqlite"
+	"github.com/linkedin-agent/pkg/logger"
+	"github.com/linkedin-agent/pkg/ratelimit"
+)
+
+// newTestAgent returns an agent backed by a fresh SQLite database and a fake LinkedIn that
+// accepts every comment
+func newTestAgent(t *testing.T, cfg config.CommenterConfig) (*Agent, *sqlite.Repository) {
+	t.Helper()
+
+	repo, err := sqlite.New(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { repo.Close() })
+	if err := repo.Migrate(); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/v2/userinfo":
+			json.NewEncoder(w).Encode(map[string]string{"sub": "abc123"})
+		case strings.HasSuffix(r.URL.Path, "/comments") && r.Method == http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]string{"id": "urn:li:comment:1"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	log := logger.New(logger.Config{Level: "error"})
+	oauth := linkedin.NewOAuthManagerEnvOnly(config.LinkedInConfig{AccessToken: "test-token"}, log)
+	limiter := ratelimit.NewMultiLimiter()
+	limiter.AddLimiter(ratelimit.LimiterLinkedIn, 1000, 100)
+	client := linkedin.NewClient(oauth, limiter, log, linkedin.WithBaseURL(server.URL))
+
+	return NewAgent(nil, client, repo, cfg, log), repo
+}
+
+// createComments saves n comments with the given status, created at createdAt
+func createComments(t *testing.T, repo *sqlite.Repository, n int, status models.CommentStatus, createdAt time.Time) {
+	t.Helper()
+
+	for i := 0; i < n; i++ {
+		comment := &models.Comment{
+			TargetPostURN: fmt.Sprintf("urn:li:share:%d%d", createdAt.Unix(), i),
+			Content:       "A thoughtful comment about the post that is long enough",
+			Status:        status,
+			CreatedAt:     createdAt,
+		}
+		if err := repo.CreateComment(context.Background(), comment); err != nil {
+			t.Fatalf("CreateComment: %v", err)
+		}
+	}
+}
+
+func TestPostCommentFindsCommentsPastTheFirstHundred(t *testing.T) {
+	ctx := context.Background()
+	agent, repo := newTestAgent(t, config.CommenterConfig{MaxCommentsPerDay: 5, ActiveHoursEnd: 24})
+	createComments(t, repo, 120, models.CommentStatusPending, time.Now().Add(-48*time.Hour))
+
+	if err := agent.PostComment(ctx, 120); err != nil {
+		t.Fatalf("PostComment: %v", err)
+	}
+
+	comment, err := repo.GetCommentByID(ctx, 120)
+	if err != nil {
+		t.Fatalf("GetCommentByID: %v", err)
+	}
+	if comment.Status != models.CommentStatusPosted {
+		t.Errorf("comment status = %s, want posted", comment.Status)
+	}
+}
+
+func TestPostCommentRespectsDailyLimit(t *testing.T) {
+	ctx := context.Background()
+	agent, repo := newTestAgent(t, config.CommenterConfig{MaxCommentsPerDay: 1, ActiveHoursEnd: 24})
+	createComments(t, repo, 1, models.CommentStatusPosted, time.Now())
+	createComments(t, repo, 1, models.CommentStatusPending, time.Now())
+
+	err := agent.PostComment(ctx, 2)
+	if err == nil || !strings.Contains(err.Error(), "daily comment limit") {
+		t.Errorf("PostComment = %v, want the daily limit error", err)
+	}
+}
@@ -2,11 +2,16 @@ package discovery
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"net/url"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/linkedin-agent/internal/ai"
+	"github.com/linkedin-agent/internal/config"
 	"github.com/linkedin-agent/internal/models"
 	"github.com/linkedin-agent/internal/source"
 	"github.com/linkedin-agent/internal/storage"
@@ -15,11 +20,16 @@ import (
 
 const maxTopicsToSave = 10
 
+// sourceSignalWeight is how much a source's native popularity signal (e.g. HN points)
+// contributes to the final score alongside the AI ranking
+const sourceSignalWeight = 0.2
+
 // Agent handles daily IT/tech news discovery from multiple sources
 type Agent struct {
 	sourceManager *source.Manager
 	aiClient      *ai.Client
 	repository    storage.Repository
+	config        config.DiscoveryConfig
 	log           *logger.Logger
 }
 
@@ -28,12 +38,14 @@ func NewAgent(
 	sourceManager *source.Manager,
 	aiClient *ai.Client,
 	repository storage.Repository,
+	cfg config.DiscoveryConfig,
 	log *logger.Logger,
 ) *Agent {
 	return &Agent{
 		sourceManager: sourceManager,
 		aiClient:      aiClient,
 		repository:    repository,
+		config:        cfg,
 		log:           log.WithComponent("discovery"),
 	}
 }
@@ -44,14 +56,17 @@ type DiscoveryResult struct {
 	TopicsRanked   int
 	TopicsSaved    int
 	TopicsSkipped  int
-	Errors         []error
+	Topics         []*models.Topic // Ranked topics kept after the top-N cut
+	DryRun         bool
+	Errors         []error // Fetch errors (*source.SourceError, by source name) first, then ranking and saving errors
 	Duration       time.Duration
 }
 
-// Run executes the discovery process
-func (a *Agent) Run(ctx context.Context) (*DiscoveryResult, error) {
+// Run executes the discovery process. With dryRun set, topics are fetched, deduplicated
+// and ranked but not saved.
+func (a *Agent) Run(ctx context.Context, dryRun bool) (*DiscoveryResult, error) {
 	startTime := time.Now()
-	result := &DiscoveryResult{}
+	result := &DiscoveryResult{DryRun: dryRun}
 
 	a.log.Info().Msg("Starting daily tech news discovery")
 
@@ -60,6 +75,13 @@ func (a *Agent) Run(ctx context.Context) (*DiscoveryResult, error) {
 	result.Errors = append(result.Errors, fetchErrors...)
 	result.TopicsFound = len(rawTopics)
 
+	for _, err := range fetchErrors {
+		var srcErr *source.SourceError
+		if errors.As(err, &srcErr) {
+			a.log.Warn().Err(srcErr.Err).Str("source", srcErr.Name).Str("type", srcErr.Type).Msg("Source fetch failed")
+		}
+	}
+
 	a.log.Info().
 		Int("topics_found", len(rawTopics)).
 		Int("fetch_errors", len(fetchErrors)).
@@ -71,8 +93,11 @@ func (a *Agent) Run(ctx context.Context) (*DiscoveryResult, error) {
 		return result, nil
 	}
 
-	// Step 2: Deduplicate topics
+	// Step 2: Drop topics from disallowed domains, then deduplicate and collapse the same
+	// story from different sources
+	rawTopics = a.filterByDomain(rawTopics)
 	uniqueTopics := a.deduplicateTopics(ctx, rawTopics)
+	uniqueTopics = a.collapseSimilarTopics(ctx, uniqueTopics)
 	a.log.Info().
 		Int("unique_topics", len(uniqueTopics)).
 		Int("duplicates_removed", len(rawTopics)-len(uniqueTopics)).
@@ -94,17 +119,20 @@ func (a *Agent) Run(ctx context.Context) (*DiscoveryResult, error) {
 			Msg("Limiting to top topics")
 		rankedTopics = rankedTopics[:maxTopicsToSave]
 	}
+	result.Topics = rankedTopics
 
-	// Step 5: Save topics to database using batch insert to avoid API rate limits
-	saved, err := a.repository.CreateTopicsBatch(ctx, rankedTopics)
-	if err != nil {
-		a.log.Error().Err(err).Msg("Failed to batch save topics")
-		result.Errors = append(result.Errors, fmt.Errorf("batch save failed: %w", err))
-		result.TopicsSkipped = len(rankedTopics)
-	} else {
-		result.TopicsSaved = saved
+	if dryRun {
+		result.Duration = time.Since(startTime)
+		a.log.Info().
+			Int("topics_ranked", result.TopicsRanked).
+			Dur("duration", result.Duration).
+			Msg("Discovery dry run completed, nothing saved")
+		return result, nil
 	}
 
+	// Step 5: Save topics to database using batch insert to avoid API rate limits
+	a.saveTopics(ctx, rankedTopics, result)
+
 	result.Duration = time.Since(startTime)
 
 	a.log.Info().
@@ -116,6 +144,90 @@ func (a *Agent) Run(ctx context.Context) (*DiscoveryResult, error) {
 	return result, nil
 }
 
+// saveTopics writes the topics in one batch. If the batch fails, each topic is inserted
+// on its own so one bad row doesn't lose the rest, and the failures are counted as skipped.
+func (a *Agent) saveTopics(ctx context.Context, topics []*models.Topic, result *DiscoveryResult) {
+	saved, err := a.repository.CreateTopicsBatch(ctx, topics)
+	if err == nil {
+		result.TopicsSaved = saved
+		return
+	}
+
+	a.log.Warn().Err(err).Int("topics", len(topics)).Msg("Batch save failed, falling back to individual inserts")
+	for _, topic := range topics {
+		// The failed batch may have assigned IDs before rolling back
+		topic.ID = 0
+		if err := a.repository.CreateTopic(ctx, topic); err != nil {
+			a.log.Warn().Err(err).Str("title", topic.Title).Msg("Failed to save topic, skipping")
+			result.Errors = append(result.Errors, fmt.Errorf("failed to save topic %q: %w", topic.Title, err))
+			result.TopicsSkipped++
+			continue
+		}
+		result.TopicsSaved++
+	}
+}
+
+// filterByDomain drops topics whose URL host is blocked or, when an allowlist is
+// configured, not allowed. Empty lists disable the filter. Topics without a URL (custom
+// keyword topics) have no domain to check and are always kept.
+func (a *Agent) filterByDomain(topics []*models.RawTopic) []*models.RawTopic {
+	if len(a.config.AllowedDomains) == 0 && len(a.config.BlockedDomains) == 0 {
+		return topics
+	}
+
+	kept := make([]*models.RawTopic, 0, len(topics))
+	for _, topic := range topics {
+		if strings.TrimSpace(topic.URL) == "" {
+			kept = append(kept, topic)
+			continue
+		}
+		host := topicHost(topic.URL)
+
+		if matchesDomain(host, a.config.BlockedDomains) {
+			a.log.Debug().Str("host", host).Str("title", topic.Title).Msg("Skipping topic from blocked domain")
+			continue
+		}
+		if len(a.config.AllowedDomains) > 0 && !matchesDomain(host, a.config.AllowedDomains) {
+			a.log.Debug().Str("host", host).Str("title", topic.Title).Msg("Skipping topic from domain not in allowlist")
+			continue
+		}
+
+		kept = append(kept, topic)
+	}
+
+	if removed := len(topics) - len(kept); removed > 0 {
+		a.log.Info().
+			Int("removed", removed).
+			Int("remaining", len(kept)).
+			Msg("Filtered topics by domain")
+	}
+
+	return kept
+}
+
+// topicHost returns the lowercased host of a topic URL without a "www." prefix
+func topicHost(rawURL string) string {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
+
+// matchesDomain reports whether host equals one of the domains or is a subdomain of one
+func matchesDomain(host string, domains []string) bool {
+	if host == "" {
+		return false
+	}
+	for _, d := range domains {
+		d = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(d)), "www.")
+		if d != "" && (host == d || strings.HasSuffix(host, "."+d)) {
+			return true
+		}
+	}
+	return false
+}
+
 // deduplicateTopics removes duplicate topics based on external ID
 func (a *Agent) deduplicateTopics(ctx context.Context, topics []*models.RawTopic) []*models.RawTopic {
 	seen := make(map[string]bool)
@@ -143,13 +255,125 @@ func (a *Agent) deduplicateTopics(ctx context.Context, topics []*models.RawTopic
 	return unique
 }
 
+// recentTopicsToCompare is how many of the latest stored topics new titles are checked against
+const recentTopicsToCompare = 200
+
+// collapseSimilarTopics drops topics whose title is a near-duplicate of a recently stored
+// topic or of another topic in this run (e.g. the same story from TechCrunch and The Verge),
+// before any AI calls are spent on them. Of near-duplicates within the run, the one with the
+// longest description is kept and the other sources are listed in its "also_reported_by".
+// Similarity is ai.JaccardSimilarity of the titles.
+func (a *Agent) collapseSimilarTopics(ctx context.Context, topics []*models.RawTopic) []*models.RawTopic {
+	threshold := a.config.TitleSimilarity
+	if threshold <= 0 {
+		return topics
+	}
+
+	stored, err := a.repository.ListTopics(ctx, storage.TopicFilter{
+		Limit:     recentTopicsToCompare,
+		OrderBy:   "discovered_at",
+		OrderDesc: true,
+	})
+	if err != nil {
+		a.log.Warn().Err(err).Msg("Failed to list recent topics, only comparing titles within this run")
+	}
+
+	kept := make([]*models.RawTopic, 0, len(topics))
+	alreadyStored := 0
+
+topics:
+	for _, topic := range topics {
+		for _, s := range stored {
+			if ai.JaccardSimilarity(topic.Title, s.Title) >= threshold {
+				a.log.Debug().
+					Str("title", topic.Title).
+					Str("stored_title", s.Title).
+					Uint("stored_id", s.ID).
+					Msg("Dropping topic similar to a stored one")
+				alreadyStored++
+				continue topics
+			}
+		}
+
+		for i, other := range kept {
+			if ai.JaccardSimilarity(topic.Title, other.Title) < threshold {
+				continue
+			}
+
+			best, dropped := other, topic
+			if len(topic.Description) > len(other.Description) {
+				best, dropped = topic, other
+				kept[i] = topic
+			}
+			if best.RawData == nil {
+				best.RawData = map[string]interface{}{}
+			}
+			alsoReported, _ := dropped.RawData["also_reported_by"].([]string)
+			if previous, ok := best.RawData["also_reported_by"].([]string); ok {
+				alsoReported = append(previous, alsoReported...)
+			}
+			best.RawData["also_reported_by"] = append(alsoReported, dropped.SourceName)
+
+			a.log.Debug().
+				Str("title", dropped.Title).
+				Str("kept_title", best.Title).
+				Str("source", dropped.SourceName).
+				Msg("Collapsing near-duplicate topic")
+			continue topics
+		}
+
+		kept = append(kept, topic)
+	}
+
+	if removed := len(topics) - len(kept); removed > 0 {
+		a.log.Info().
+			Int("removed", removed).
+			Int("similar_to_stored", alreadyStored).
+			Int("remaining", len(kept)).
+			Msg("Collapsed near-duplicate topics")
+	}
+
+	return kept
+}
+
+// Backoff for ranking batches that fail with a Claude rate-limit or overload error
+const (
+	rankRetries    = 3
+	rankRetryDelay = 20 * time.Second
+)
+
+// rankBatch ranks one batch, backing off and retrying while Claude reports it is rate
+// limited or overloaded instead of giving up on the batch
+func (a *Agent) rankBatch(ctx context.Context, batch []*models.RawTopic) ([]*ai.TopicRanking, error) {
+	delay := rankRetryDelay
+	for attempt := 0; ; attempt++ {
+		rankings, err := a.aiClient.RankTopics(ctx, batch)
+		if !ai.IsTransient(err) || attempt >= rankRetries {
+			return rankings, err
+		}
+
+		a.log.Warn().
+			Err(err).
+			Int("attempt", attempt+1).
+			Dur("delay", delay).
+			Msg("Claude unavailable, retrying topic batch")
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
 // rankTopics uses AI to rank topics and converts them to models.Topic
 func (a *Agent) rankTopics(ctx context.Context, rawTopics []*models.RawTopic) ([]*models.Topic, []error) {
-	var errors []error
+	var errs []error
 	topics := make([]*models.Topic, 0, len(rawTopics))
 
-	// Process in batches of 10
-	batchSize := 10
+	// Process in batches sized by anthropic.rank_batch_size
+	batchSize := a.aiClient.RankBatchSize()
 	for i := 0; i < len(rawTopics); i += batchSize {
 		end := i + batchSize
 		if end > len(rawTopics) {
@@ -162,10 +386,19 @@ func (a *Agent) rankTopics(ctx context.Context, rawTopics []*models.RawTopic) ([
 			Int("batch_size", len(batch)).
 			Msg("Ranking topic batch")
 
-		rankings, err := a.aiClient.RankTopics(ctx, batch)
+		rankings, err := a.rankBatch(ctx, batch)
+		if errors.Is(err, ai.ErrBudgetExceeded) {
+			// Keep the topics ranked so far, the remaining batches would fail the same way
+			a.log.Warn().
+				Err(err).
+				Int("unranked", len(rawTopics)-i).
+				Msg("Claude budget exhausted, stopping ranking")
+			errs = append(errs, fmt.Errorf("ranking stopped: %w", err))
+			break
+		}
 		if err != nil {
 			a.log.Error().Err(err).Msg("Failed to rank topic batch")
-			errors = append(errors, fmt.Errorf("batch ranking failed: %w", err))
+			errs = append(errs, fmt.Errorf("batch ranking failed: %w", err))
 			continue
 		}
 
@@ -191,19 +424,33 @@ func (a *Agent) rankTopics(ctx context.Context, rawTopics []*models.RawTopic) ([
 					"hashtags":        rankings[j].Hashtags,
 					"original_data":   raw.RawData,
 				}
+
+				// Blend in the source's own popularity signal when it provides one
+				if signal, ok := raw.RawData["signal_score"].(float64); ok {
+					topic.RawData["ai_score"] = topic.AIScore
+					topic.AIScore = topic.AIScore*(1-sourceSignalWeight) + signal*sourceSignalWeight
+				}
+
+				// Bias toward trusted sources (e.g. sources.rss.feeds[].weight)
+				if weight, ok := raw.RawData["source_weight"].(float64); ok {
+					topic.RawData["source_weight"] = weight
+					topic.RawData["unweighted_score"] = topic.AIScore
+					topic.AIScore = math.Max(0, math.Min(100, topic.AIScore*weight))
+					topic.RawData["weighted_score"] = topic.AIScore
+				}
 			}
 
 			topics = append(topics, topic)
 		}
 	}
 
-	return topics, errors
+	return topics, errs
 }
 
-// RunForSource runs discovery for a specific source
-func (a *Agent) RunForSource(ctx context.Context, sourceName string) (*DiscoveryResult, error) {
+// RunForSource runs discovery for a specific source. With dryRun set, nothing is saved.
+func (a *Agent) RunForSource(ctx context.Context, sourceName string, dryRun bool) (*DiscoveryResult, error) {
 	startTime := time.Now()
-	result := &DiscoveryResult{}
+	result := &DiscoveryResult{DryRun: dryRun}
 
 	src := a.sourceManager.GetSourceByName(sourceName)
 	if src == nil {
@@ -213,16 +460,22 @@ func (a *Agent) RunForSource(ctx context.Context, sourceName string) (*Discovery
 	a.log.Info().Str("source", sourceName).Msg("Running discovery for source")
 
 	rawTopics, err := src.Fetch(ctx)
-	if err != nil {
+	if err != nil && len(rawTopics) == 0 {
 		return nil, fmt.Errorf("failed to fetch from %s: %w", sourceName, err)
 	}
 
 	result.TopicsFound = len(rawTopics)
 
-	// Deduplicate and rank
+	// Filter by domain, deduplicate, collapse near-duplicate stories and rank
+	rawTopics = a.filterByDomain(rawTopics)
 	uniqueTopics := a.deduplicateTopics(ctx, rawTopics)
+	uniqueTopics = a.collapseSimilarTopics(ctx, uniqueTopics)
 	rankedTopics, rankErrors := a.rankTopics(ctx, uniqueTopics)
 	result.Errors = rankErrors
+	if err != nil {
+		// Partial fetch: keep going with what the source returned
+		result.Errors = append([]error{&source.SourceError{Name: src.Name(), Type: src.Type(), Err: err}}, result.Errors...)
+	}
 	result.TopicsRanked = len(rankedTopics)
 
 	// Sort by score and keep only top N topics
@@ -232,17 +485,16 @@ func (a *Agent) RunForSource(ctx context.Context, sourceName string) (*Discovery
 	if len(rankedTopics) > maxTopicsToSave {
 		rankedTopics = rankedTopics[:maxTopicsToSave]
 	}
+	result.Topics = rankedTopics
 
-	// Save using batch insert
-	saved, err := a.repository.CreateTopicsBatch(ctx, rankedTopics)
-	if err != nil {
-		a.log.Error().Err(err).Msg("Failed to batch save topics")
-		result.Errors = append(result.Errors, fmt.Errorf("batch save failed: %w", err))
-		result.TopicsSkipped = len(rankedTopics)
-	} else {
-		result.TopicsSaved = saved
+	if dryRun {
+		result.Duration = time.Since(startTime)
+		return result, nil
 	}
 
+	// Save using batch insert
+	a.saveTopics(ctx, rankedTopics, result)
+
 	result.Duration = time.Since(startTime)
 	return result, nil
 }
@@ -0,0 +1,80 @@
+package discovery
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/linkedin-agent/internal/config"
+	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/internal/storage/sqlite"
+	"github.com/linkedin-agent/pkg/logger"
+)
+
+func newTestAgent(t *testing.T, cfg config.DiscoveryConfig) (*Agent, *sqlite.Repository) {
+	t.Helper()
+
+	repo, err := sqlite.New(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { repo.Close() })
+	if err := repo.Migrate(); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	log := logger.New(logger.Config{Level: "error"})
+	return NewAgent(nil, nil, repo, cfg, log), repo
+}
+
+func TestCollapseSimilarTopics(t *testing.T) {
+	ctx := context.Background()
+	agent, repo := newTestAgent(t, config.DiscoveryConfig{TitleSimilarity: 0.6})
+
+	stored := &models.Topic{ExternalID: "rss_old", Title: "Go 1.25 released with faster garbage collector", Status: models.TopicStatusApproved}
+	if err := repo.CreateTopic(ctx, stored); err != nil {
+		t.Fatalf("CreateTopic: %v", err)
+	}
+
+	topics := []*models.RawTopic{
+		{Title: "OpenAI announces new reasoning model", Description: "short", SourceName: "TechCrunch"},
+		{Title: "Go 1.25 released with a faster garbage collector", SourceName: "Hacker News"},
+		{Title: "OpenAI announces a new reasoning model", Description: "a much longer description", SourceName: "The Verge"},
+		{Title: "Rust 2024 edition is stable", SourceName: "Lobsters"},
+	}
+
+	kept := agent.collapseSimilarTopics(ctx, topics)
+
+	if len(kept) != 2 {
+		t.Fatalf("kept %d topics, want 2", len(kept))
+	}
+	if kept[0].SourceName != "The Verge" {
+		t.Errorf("kept %q of the duplicate pair, want the one with the longer description", kept[0].SourceName)
+	}
+	if also, _ := kept[0].RawData["also_reported_by"].([]string); len(also) != 1 || also[0] != "TechCrunch" {
+		t.Errorf("also_reported_by = %v, want [TechCrunch]", kept[0].RawData["also_reported_by"])
+	}
+	if kept[1].SourceName != "Lobsters" {
+		t.Errorf("kept %q, want the unrelated story", kept[1].SourceName)
+	}
+}
+
+func TestFilterByDomainKeepsTopicsWithoutURL(t *testing.T) {
+	agent, _ := newTestAgent(t, config.DiscoveryConfig{AllowedDomains: []string{"example.com"}})
+
+	topics := []*models.RawTopic{
+		{Title: "allowed", URL: "https://blog.example.com/post"},
+		{Title: "other domain", URL: "https://elsewhere.org/post"},
+		{Title: "custom keyword topic", SourceType: "custom"},
+	}
+
+	kept := agent.filterByDomain(topics)
+
+	if len(kept) != 2 || kept[0].Title != "allowed" || kept[1].Title != "custom keyword topic" {
+		titles := make([]string, len(kept))
+		for i, topic := range kept {
+			titles[i] = topic.Title
+		}
+		t.Errorf("kept %v, want [allowed custom keyword topic]", titles)
+	}
+}
@@ -4,29 +4,37 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/linkedin-agent/internal/ai"
 	"github.com/linkedin-agent/internal/config"
 	"github.com/linkedin-agent/internal/linkedin"
-	"github.com/linkedin-agent/internal/media/unsplash"
+	"github.com/linkedin-agent/internal/media"
 	"github.com/linkedin-agent/internal/models"
 	"github.com/linkedin-agent/internal/storage"
 	"github.com/linkedin-agent/internal/tracker"
 	"github.com/linkedin-agent/pkg/logger"
+	"github.com/robfig/cron/v3"
 )
 
 // Agent handles content generation and publishing to LinkedIn
 type Agent struct {
-	aiClient        *ai.Client
-	linkedinClient  *linkedin.Client
-	repository      storage.Repository
-	config          config.PublishingConfig
-	mediaConfig     config.MediaConfig
-	unsplashClient  *unsplash.Client
-	log             *logger.Logger
-	tracker         *tracker.SheetsTracker
+	aiClient       *ai.Client
+	linkedinClient *linkedin.Client
+	repository     storage.Repository
+	config         config.PublishingConfig
+	mediaConfig    config.MediaConfig
+	imageProvider  media.Provider
+	log            *logger.Logger
+	tracker        *tracker.SheetsTracker
+	publishWindows []cron.Schedule // Scheduler publish crons, used to report when scheduled posts go out
+	location       *time.Location  // Time zone of the publish windows and the daily limit (nil = server time)
 }
 
 // NewAgent creates a new publisher agent
@@ -46,15 +54,71 @@ func NewAgent(
 	}
 }
 
+// SetPublishWindows sets the scheduler's publish crons and their time zone, so SchedulePost
+// can report when a post scheduled outside a window will actually be published
+func (a *Agent) SetPublishWindows(crons []string, loc *time.Location) error {
+	windows := make([]cron.Schedule, 0, len(crons))
+	for _, expr := range crons {
+		schedule, err := cron.ParseStandard(expr)
+		if err != nil {
+			return fmt.Errorf("invalid publish cron %q: %w", expr, err)
+		}
+		windows = append(windows, schedule)
+	}
+	a.publishWindows = windows
+	a.location = loc
+	return nil
+}
+
+// now returns the current time in the publishing time zone
+func (a *Agent) now() time.Time {
+	if a.location == nil {
+		return time.Now()
+	}
+	return time.Now().In(a.location)
+}
+
+// NextPublishWindow returns the first publish window at or after t, which is when the
+// scheduler would publish a post scheduled for t. Returns t when no windows are set.
+func (a *Agent) NextPublishWindow(t time.Time) time.Time {
+	if len(a.publishWindows) == 0 {
+		return t
+	}
+
+	// Next is exclusive, so step back a moment to accept t when it is itself a window
+	from := t.Add(-time.Nanosecond)
+	if a.location != nil {
+		from = from.In(a.location)
+	}
+	var next time.Time
+	for _, window := range a.publishWindows {
+		if at := window.Next(from); next.IsZero() || at.Before(next) {
+			next = at
+		}
+	}
+	return next
+}
+
 // SetTracker sets the Google Sheets tracker for the agent
 func (a *Agent) SetTracker(t *tracker.SheetsTracker) {
 	a.tracker = t
 }
 
-// SetMediaConfig configures media/image support for the agent
-func (a *Agent) SetMediaConfig(mediaCfg config.MediaConfig, unsplashClient *unsplash.Client) {
+// SetMediaConfig configures media/image support and the provider images are picked from
+func (a *Agent) SetMediaConfig(mediaCfg config.MediaConfig, provider media.Provider) {
 	a.mediaConfig = mediaCfg
-	a.unsplashClient = unsplashClient
+	a.imageProvider = provider
+}
+
+// imagesEnabled reports whether new posts should get an image attached
+func (a *Agent) imagesEnabled() bool {
+	return a.mediaConfig.Enabled && a.imageProvider != nil
+}
+
+// fallbackToText reports whether a failed image upload should be published as text instead.
+// Without media configured, stored images are best-effort.
+func (a *Agent) fallbackToText() bool {
+	return a.mediaConfig.FallbackToText || !a.mediaConfig.Enabled
 }
 
 // GenerateResult contains the result of content generation
@@ -63,12 +127,105 @@ type GenerateResult struct {
 	Preview string
 }
 
-// GenerateContent creates content for a topic
-func (a *Agent) GenerateContent(ctx context.Context, topicID uint, postType models.PostType) (*GenerateResult, error) {
+// GenerateContent creates content for a topic and saves it as a draft. templateName
+// optionally selects one of the configured post templates for text posts, and voiceName
+// one of the configured brand voices (empty = publishing.brand_voice).
+func (a *Agent) GenerateContent(ctx context.Context, topicID uint, postType models.PostType, templateName, voiceName string) (*GenerateResult, error) {
+	topic, post, preview, err := a.draftPost(ctx, topicID, postType, templateName, voiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save draft
+	if err := a.repository.CreatePost(ctx, post); err != nil {
+		return nil, fmt.Errorf("failed to save post: %w", err)
+	}
+
+	// Track in Google Sheets
+	if a.tracker != nil {
+		if err := a.tracker.TrackNewPost(ctx, topic, post); err != nil {
+			a.log.Warn().Err(err).Msg("Failed to track post in Google Sheets")
+		}
+	}
+
+	// Determine if should auto-publish based on hybrid approval mode
+	// (near-duplicates always stay drafts for review, articles need their URL set first)
+	_, nearDuplicate := post.AIMetadata["near_duplicate_of"]
+	if topic.IsHighScore() && a.config.AutoApprove && !nearDuplicate && post.PostType != models.PostTypeArticle {
+		post.Status = models.PostStatusScheduled
+		now := time.Now()
+		post.ScheduledFor = &now
+		if err := a.repository.UpdatePost(ctx, post); err != nil {
+			a.log.Warn().Err(err).Msg("Failed to schedule high-score post")
+		}
+		// Update tracker with scheduled status
+		if a.tracker != nil {
+			a.tracker.UpdatePostScheduled(ctx, topic.ID, now)
+		}
+	}
+
+	a.log.Info().
+		Uint("post_id", post.ID).
+		Float64("topic_score", topic.AIScore).
+		Bool("auto_scheduled", post.Status == models.PostStatusScheduled).
+		Msg("Content generated")
+
+	return &GenerateResult{
+		Post:    post,
+		Preview: preview,
+	}, nil
+}
+
+// PreviewContent runs the same generation and post-processing as GenerateContent (header,
+// footer, image selection) but does not save or track the post. The returned post has no ID;
+// a selected image is in MediaURL with its attribution in AIMetadata["image_attribution"].
+func (a *Agent) PreviewContent(ctx context.Context, topicID uint, postType models.PostType) (*GenerateResult, error) {
+	_, post, preview, err := a.draftPost(ctx, topicID, postType, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	a.log.Info().
+		Uint("topic_id", topicID).
+		Str("post_type", string(post.PostType)).
+		Msg("Content previewed")
+
+	return &GenerateResult{
+		Post:    post,
+		Preview: preview,
+	}, nil
+}
+
+// draftPost generates an unsaved draft post for a topic, with its image attached when media
+// is enabled. Also returns the topic and the preview text to show the user.
+func (a *Agent) draftPost(ctx context.Context, topicID uint, postType models.PostType, templateName, voiceName string) (*models.Topic, *models.Post, string, error) {
+	opts := ai.ContentOptions{
+		Author:          a.config.Author,
+		TargetWordCount: a.config.TargetWordCount,
+		MaxCharacters:   a.config.MaxCharacters,
+	}
+	if templateName != "" {
+		// Viper lowercases map keys, so template names are case-insensitive
+		template, ok := a.config.Templates[strings.ToLower(templateName)]
+		if !ok {
+			return nil, nil, "", fmt.Errorf("unknown post template %q (available: %s)", templateName, strings.Join(a.templateNames(), ", "))
+		}
+		opts.Template = template
+	}
+	brandVoice, err := a.brandVoice(voiceName)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	opts.AvoidOpeners = a.recentHookOpeners(ctx)
+
 	// Get topic
 	topic, err := a.repository.GetTopicByID(ctx, topicID)
 	if err != nil {
-		return nil, fmt.Errorf("topic not found: %w", err)
+		return nil, nil, "", fmt.Errorf("topic not found: %w", err)
+	}
+
+	if err := a.checkTopicCooldown(ctx, topic); err != nil {
+		return nil, nil, "", err
 	}
 
 	a.log.Info().
@@ -77,12 +234,37 @@ func (a *Agent) GenerateContent(ctx context.Context, topicID uint, postType mode
 		Msg("Generating content")
 
 	var post *models.Post
+	preview := ""
 
 	switch postType {
+	case models.PostTypeArticle:
+		article, err := a.aiClient.GenerateArticle(ctx, []ai.DigestTopic{{
+			Title:       topic.Title,
+			Description: topic.Description,
+			Source:      topic.SourceName,
+		}}, brandVoice)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("failed to generate article: %w", err)
+		}
+		post = newArticlePost(article, []uint{topic.ID}, "")
+		preview = articlePreview(article)
+
 	case models.PostTypePoll:
-		poll, err := a.aiClient.GeneratePoll(ctx, topic, a.config.BrandVoice)
+		duration, err := pollDurationSetting(a.config.PollDurationDays)
+		if err != nil {
+			return nil, nil, "", err
+		}
+
+		poll, err := a.aiClient.GeneratePoll(ctx, topic, brandVoice, opts)
 		if err != nil {
-			return nil, fmt.Errorf("failed to generate poll: %w", err)
+			return nil, nil, "", fmt.Errorf("failed to generate poll: %w", err)
+		}
+		if len(poll.Options) > linkedin.MaxPollOptions {
+			a.log.Warn().Int("options", len(poll.Options)).Msg("Poll has too many options, keeping the first four")
+		}
+		options, err := linkedin.NormalizePollOptions(poll.Options)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("generated poll is invalid: %w", err)
 		}
 
 		post = &models.Post{
@@ -92,19 +274,40 @@ func (a *Agent) GenerateContent(ctx context.Context, topicID uint, postType mode
 			GenerationPrompt: fmt.Sprintf("Generate poll for: %s", topic.Title),
 			PostFormat: models.JSON{
 				"question": poll.Question,
-				"options":  poll.Options,
-				"duration": "THREE_DAYS",
+				"options":  options,
+				"duration": duration,
 			},
 			AIMetadata: models.JSON{
 				"hashtags": poll.Hashtags,
 			},
 			Status: models.PostStatusDraft,
 		}
+		if poll.Raw != nil {
+			post.AIMetadata["raw_response"] = poll.Raw
+		}
 
 	default: // Text post
-		content, err := a.aiClient.GenerateContent(ctx, topic, a.config.BrandVoice)
+		content, err := a.aiClient.GenerateContent(ctx, topic, brandVoice, opts)
 		if err != nil {
-			return nil, fmt.Errorf("failed to generate content: %w", err)
+			return nil, nil, "", fmt.Errorf("failed to generate content: %w", err)
+		}
+
+		// Regenerate once if the header plus hook pushes the hook past the "see more" fold
+		hookFits := ai.HookFitsFold(content.Content, a.config.HookFoldLength)
+		if !hookFits {
+			a.log.Warn().
+				Int("fold_length", a.config.HookFoldLength).
+				Msg("Hook extends past the fold, regenerating content")
+			retry, err := a.aiClient.GenerateContent(ctx, topic, brandVoice, opts)
+			if err != nil {
+				a.log.Warn().Err(err).Msg("Failed to regenerate content, keeping original")
+			} else {
+				content = retry
+				hookFits = ai.HookFitsFold(content.Content, a.config.HookFoldLength)
+			}
+			if !hookFits {
+				a.log.Warn().Msg("Hook still extends past the fold, review before publishing")
+			}
 		}
 
 		// Use AI-generated content directly (post-processing adds header/footer in ai/ranking.go)
@@ -116,52 +319,216 @@ func (a *Agent) GenerateContent(ctx context.Context, topicID uint, postType mode
 			PostType:         models.PostTypeText,
 			GenerationPrompt: fmt.Sprintf("Generate LinkedIn post for: %s", topic.Title),
 			AIMetadata: models.JSON{
-				"hook":     content.Hook,
-				"cta":      content.CTA,
-				"hashtags": content.Hashtags,
+				"hook":           content.Hook,
+				"cta":            content.CTA,
+				"hashtags":       content.Hashtags,
+				"hook_fits_fold": hookFits,
 			},
 			Status: models.PostStatusDraft,
 		}
+		if templateName != "" {
+			post.AIMetadata["template"] = templateName
+		}
+		if content.Raw != nil {
+			post.AIMetadata["raw_response"] = content.Raw
+		}
+		a.flagStatistics(post)
+		a.flagNearDuplicate(ctx, post)
+	}
+
+	if voiceName != "" {
+		if post.AIMetadata == nil {
+			post.AIMetadata = models.JSON{}
+		}
+		post.AIMetadata["voice"] = strings.ToLower(voiceName)
 	}
 
 	// Attach image if media is enabled (before saving so image info is persisted)
-	if a.mediaConfig.Enabled && a.unsplashClient != nil && postType == models.PostTypeText {
+	if a.imagesEnabled() && postType == models.PostTypeText {
 		if err := a.AttachImageToPost(ctx, post, topic); err != nil {
 			a.log.Warn().Err(err).Msg("Failed to attach image to post, will publish as text-only")
 		}
 	}
 
-	// Save draft
-	if err := a.repository.CreatePost(ctx, post); err != nil {
-		return nil, fmt.Errorf("failed to save post: %w", err)
+	if preview == "" {
+		preview = post.Content
 	}
 
-	// Track in Google Sheets
-	if a.tracker != nil {
-		if err := a.tracker.TrackNewPost(ctx, topic, post); err != nil {
-			a.log.Warn().Err(err).Msg("Failed to track post in Google Sheets")
+	return topic, post, preview, nil
+}
+
+// nearDuplicateLookback is how many recent posts a new draft is compared against
+const nearDuplicateLookback = 50
+
+// flagNearDuplicate marks the post as a near-duplicate in its AIMetadata when its content is
+// at least NearDuplicateThreshold similar to a recent draft, scheduled or published post.
+// This catches two topics about the same story both producing a post.
+func (a *Agent) flagNearDuplicate(ctx context.Context, post *models.Post) {
+	if a.config.NearDuplicateThreshold <= 0 {
+		return
+	}
+
+	recent, err := a.repository.ListPosts(ctx, storage.PostFilter{
+		Limit:     nearDuplicateLookback,
+		OrderBy:   "created_at",
+		OrderDesc: true,
+	})
+	if err != nil {
+		a.log.Warn().Err(err).Msg("Failed to load recent posts for duplicate check")
+		return
+	}
+
+	var match *models.Post
+	best := 0.0
+	for _, other := range recent {
+		if other.Status == models.PostStatusFailed || other.ID == post.ID {
+			continue
+		}
+		if score := ai.JaccardSimilarity(post.Content, other.Content); score > best {
+			best, match = score, other
 		}
 	}
 
-	// Determine if should auto-publish based on hybrid approval mode
-	if topic.IsHighScore() && a.config.AutoApprove {
-		post.Status = models.PostStatusScheduled
-		now := time.Now()
-		post.ScheduledFor = &now
-		if err := a.repository.UpdatePost(ctx, post); err != nil {
-			a.log.Warn().Err(err).Msg("Failed to schedule high-score post")
+	if match == nil || best < a.config.NearDuplicateThreshold {
+		return
+	}
+
+	post.AIMetadata["near_duplicate_of"] = match.ID
+	post.AIMetadata["similarity"] = best
+	a.log.Warn().
+		Uint("similar_post_id", match.ID).
+		Float64("similarity", best).
+		Msg("Generated content is a near-duplicate of a recent post, keeping as draft for review")
+}
+
+// recentHookOpeners returns the distinct openers of recently generated hooks, so new
+// content can vary its hook formula
+func (a *Agent) recentHookOpeners(ctx context.Context) []string {
+	if a.config.AvoidRecentHooks <= 0 {
+		return nil
+	}
+
+	hooks, err := a.repository.GetRecentHooks(ctx, a.config.AvoidRecentHooks)
+	if err != nil {
+		a.log.Warn().Err(err).Msg("Failed to load recent hooks")
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	var openers []string
+	for _, hook := range hooks {
+		opener := ai.HookOpener(hook)
+		if key := strings.ToLower(opener); opener != "" && !seen[key] {
+			seen[key] = true
+			openers = append(openers, opener)
 		}
-		// Update tracker with scheduled status
-		if a.tracker != nil {
-			a.tracker.UpdatePostScheduled(ctx, topic.ID, now)
+	}
+	return openers
+}
+
+// RegenerateContent rewrites a text draft from its original topic, following the reviewer's
+// feedback. The draft is updated in place so its ID and tracker row are kept; the feedback
+// is recorded in AIMetadata["feedback"].
+func (a *Agent) RegenerateContent(ctx context.Context, postID uint, feedback string) (*GenerateResult, error) {
+	post, err := a.repository.GetPostByID(ctx, postID)
+	if err != nil {
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+
+	if post.Status != models.PostStatusDraft {
+		return nil, fmt.Errorf("post %d is %s, only drafts can be regenerated", postID, post.Status)
+	}
+	if post.PostType != models.PostTypeText {
+		return nil, fmt.Errorf("post %d is a %s post, only text posts can be regenerated", postID, post.PostType)
+	}
+	if isDigest, _ := post.AIMetadata["is_digest"].(bool); isDigest {
+		return nil, fmt.Errorf("post %d is a digest, regenerate it with 'publish digest'", postID)
+	}
+	if isRecap, _ := post.AIMetadata["is_weekly_recap"].(bool); isRecap {
+		return nil, fmt.Errorf("post %d is a weekly recap, regenerate it with 'publish weekly-recap'", postID)
+	}
+	if post.TopicID == nil {
+		return nil, fmt.Errorf("post %d has no topic to regenerate from", postID)
+	}
+
+	topic := post.Topic
+	if topic == nil {
+		if topic, err = a.repository.GetTopicByID(ctx, *post.TopicID); err != nil {
+			return nil, fmt.Errorf("topic not found: %w", err)
 		}
 	}
 
+	opts := ai.ContentOptions{
+		Author:          a.config.Author,
+		TargetWordCount: a.config.TargetWordCount,
+		MaxCharacters:   a.config.MaxCharacters,
+		PreviousDraft:   post.Content,
+		Feedback:        feedback,
+	}
+	templateName, _ := post.AIMetadata["template"].(string)
+	if template, ok := a.config.Templates[strings.ToLower(templateName)]; ok && templateName != "" {
+		opts.Template = template
+	}
+	voiceName, _ := post.AIMetadata["voice"].(string)
+	brandVoice, err := a.brandVoice(voiceName)
+	if err != nil {
+		a.log.Warn().Err(err).Msg("Voice of the original draft is no longer configured, using the default brand voice")
+		brandVoice = a.config.BrandVoice
+	}
+
 	a.log.Info().
-		Uint("post_id", post.ID).
-		Float64("topic_score", topic.AIScore).
-		Bool("auto_scheduled", post.Status == models.PostStatusScheduled).
-		Msg("Content generated")
+		Uint("post_id", postID).
+		Str("feedback", feedback).
+		Msg("Regenerating content with feedback")
+
+	content, err := a.aiClient.GenerateContent(ctx, topic, brandVoice, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to regenerate content: %w", err)
+	}
+
+	if post.AIMetadata == nil {
+		post.AIMetadata = models.JSON{}
+	}
+
+	// Keep every round of feedback for auditing
+	var history []interface{}
+	if previous, ok := post.AIMetadata["feedback"].([]interface{}); ok {
+		history = previous
+	}
+	post.AIMetadata["feedback"] = append(history, map[string]interface{}{
+		"feedback": feedback,
+		"at":       time.Now().Format(time.RFC3339),
+	})
+
+	post.Content = content.Content
+	post.AIMetadata["hook"] = content.Hook
+	post.AIMetadata["cta"] = content.CTA
+	post.AIMetadata["hashtags"] = content.Hashtags
+	post.AIMetadata["hook_fits_fold"] = ai.HookFitsFold(content.Content, a.config.HookFoldLength)
+	if content.Raw != nil {
+		post.AIMetadata["raw_response"] = content.Raw
+	}
+
+	// Re-run the review flags against the new wording
+	delete(post.AIMetadata, "flagged_claims")
+	delete(post.AIMetadata, "near_duplicate_of")
+	delete(post.AIMetadata, "similarity")
+	a.flagStatistics(post)
+	a.flagNearDuplicate(ctx, post)
+
+	if err := a.repository.UpdatePost(ctx, post); err != nil {
+		return nil, fmt.Errorf("failed to save regenerated post: %w", err)
+	}
+
+	if a.tracker != nil {
+		if err := a.tracker.UpdatePostGenerated(ctx, topic.ID, post.Content, string(post.PostType)); err != nil {
+			a.log.Warn().Err(err).Msg("Failed to update post in Google Sheets")
+		}
+	}
+
+	a.log.Info().
+		Uint("post_id", postID).
+		Msg("Content regenerated")
 
 	return &GenerateResult{
 		Post:    post,
@@ -169,6 +536,85 @@ func (a *Agent) GenerateContent(ctx context.Context, topicID uint, postType mode
 	}, nil
 }
 
+// templateNames returns the configured post template names in sorted order
+func (a *Agent) templateNames() []string {
+	return sortedKeys(a.config.Templates)
+}
+
+// brandVoice returns the text of the named voice profile from publishing.voices,
+// or publishing.brand_voice when name is empty
+func (a *Agent) brandVoice(name string) (string, error) {
+	if name == "" {
+		return a.config.BrandVoice, nil
+	}
+	// Viper lowercases map keys, so voice names are case-insensitive
+	voice, ok := a.config.Voices[strings.ToLower(name)]
+	if !ok {
+		return "", fmt.Errorf("unknown brand voice %q (available: %s)", name, strings.Join(sortedKeys(a.config.Voices), ", "))
+	}
+	return voice, nil
+}
+
+// sortedKeys returns the keys of a config map in alphabetical order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// flagStatistics records numeric claims in the post's AIMetadata for manual fact-checking
+func (a *Agent) flagStatistics(post *models.Post) {
+	if !a.config.FlagStatistics {
+		return
+	}
+
+	claims := ai.ExtractStatisticClaims(post.Content)
+	if len(claims) == 0 {
+		return
+	}
+
+	post.AIMetadata["flagged_claims"] = claims
+	a.log.Warn().
+		Int("claims", len(claims)).
+		Strs("flagged_claims", claims).
+		Msg("Generated content contains statistics, verify before publishing")
+}
+
+// checkTopicCooldown rejects a topic that was published less than GlobalTopicCooldownHours ago.
+// Posts are looked up by topic in the shared storage, so this holds across every brand using it.
+func (a *Agent) checkTopicCooldown(ctx context.Context, topic *models.Topic) error {
+	if a.config.GlobalTopicCooldownHours <= 0 {
+		return nil
+	}
+
+	status := models.PostStatusPublished
+	posts, err := a.repository.ListPosts(ctx, storage.PostFilter{
+		Status:  &status,
+		TopicID: &topic.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check topic cooldown: %w", err)
+	}
+
+	var lastPostedAt *time.Time
+	for _, p := range posts {
+		if p.PublishedAt != nil && (lastPostedAt == nil || p.PublishedAt.After(*lastPostedAt)) {
+			lastPostedAt = p.PublishedAt
+		}
+	}
+
+	cooldown := time.Duration(a.config.GlobalTopicCooldownHours) * time.Hour
+	if lastPostedAt != nil && time.Since(*lastPostedAt) < cooldown {
+		return fmt.Errorf("topic %d was posted %s ago, cooldown is %dh",
+			topic.ID, time.Since(*lastPostedAt).Round(time.Minute), a.config.GlobalTopicCooldownHours)
+	}
+
+	return nil
+}
+
 // PublishResult contains the result of publishing
 type PublishResult struct {
 	PostID      uint
@@ -177,9 +623,9 @@ type PublishResult struct {
 	Error       error
 }
 
-// AttachImageToPost fetches an image from Unsplash and attaches it to the post
+// AttachImageToPost fetches an image from the configured image provider and attaches it to the post
 func (a *Agent) AttachImageToPost(ctx context.Context, post *models.Post, topic *models.Topic) error {
-	if !a.mediaConfig.Enabled || a.unsplashClient == nil {
+	if !a.imagesEnabled() {
 		return nil
 	}
 
@@ -190,27 +636,25 @@ func (a *Agent) AttachImageToPost(ctx context.Context, post *models.Post, topic
 		keywords = &ai.ImageSearchKeywords{Primary: topic.Title}
 	}
 
-	// Search for a photo
-	photo, err := a.unsplashClient.GetBestPhoto(ctx, keywords.Primary)
+	// The provider falls back to the secondary keywords when the primary one finds nothing
+	imageURL, attribution, imageID, err := a.imageProvider.GetBestImage(ctx, append([]string{keywords.Primary}, keywords.Keywords...))
 	if err != nil {
-		a.log.Warn().Err(err).Str("keyword", keywords.Primary).Msg("Failed to find image")
-		return err
+		return fmt.Errorf("failed to find image: %w", err)
 	}
 
-	// Store the photo URL (we'll download and upload during publish)
+	// Store the image URL (we'll download and upload during publish)
 	post.MediaType = models.MediaTypeImage
-	post.MediaURL = photo.URLs.Regular
+	post.MediaURL = imageURL
 
 	// Store attribution in AI metadata
 	if post.AIMetadata == nil {
 		post.AIMetadata = models.JSON{}
 	}
-	post.AIMetadata["image_attribution"] = a.unsplashClient.GetAttribution(photo)
-	post.AIMetadata["image_id"] = photo.ID
+	post.AIMetadata["image_attribution"] = attribution
+	post.AIMetadata["image_id"] = imageID
 
 	a.log.Info().
-		Str("photo_id", photo.ID).
-		Str("photographer", photo.User.Name).
+		Str("image_id", imageID).
 		Str("keyword", keywords.Primary).
 		Msg("Image attached to post")
 
@@ -232,7 +676,7 @@ func (a *Agent) publishWithImage(ctx context.Context, post *models.Post) (string
 	// Download the image directly from the stored URL
 	imageData, err := a.downloadImageFromURL(ctx, post.MediaURL)
 	if err != nil || len(imageData) == 0 {
-		if a.mediaConfig.FallbackToText {
+		if a.fallbackToText() {
 			a.log.Warn().Err(err).Msg("Failed to download image, falling back to text post")
 			return a.linkedinClient.CreatePost(ctx, post)
 		}
@@ -242,7 +686,7 @@ func (a *Agent) publishWithImage(ctx context.Context, post *models.Post) (string
 	// Upload to LinkedIn and create post
 	postURN, assetURN, err := a.linkedinClient.UploadAndCreateImagePost(ctx, post, imageData)
 	if err != nil {
-		if a.mediaConfig.FallbackToText {
+		if a.fallbackToText() {
 			a.log.Warn().Err(err).Msg("Failed to upload image to LinkedIn, falling back to text post")
 			return a.linkedinClient.CreatePost(ctx, post)
 		}
@@ -300,11 +744,21 @@ func (a *Agent) Publish(ctx context.Context, postID uint) (*PublishResult, error
 			for i, o := range optionsRaw {
 				options[i], _ = o.(string)
 			}
-			urn, err = a.linkedinClient.CreatePoll(ctx, question, options, 3)
+			duration, _ := post.PostFormat["duration"].(string)
+			urn, err = a.linkedinClient.CreatePoll(ctx, question, options, pollDurationDays(duration))
+		}
+	case models.PostTypeArticle:
+		articleURL, _ := post.PostFormat["url"].(string)
+		if articleURL == "" {
+			err = fmt.Errorf("article post has no URL: publish the article body on LinkedIn, then set it with 'publish article --post-id %d --url <article-url>'", post.ID)
+		} else {
+			title, _ := post.PostFormat["title"].(string)
+			subtitle, _ := post.PostFormat["subtitle"].(string)
+			urn, err = a.linkedinClient.CreateArticlePost(ctx, post.Content, articleURL, title, subtitle)
 		}
 	default:
-		// Check if post has image to upload
-		if post.MediaType == models.MediaTypeImage && post.MediaURL != "" && a.unsplashClient != nil {
+		// Check if post has image to upload (the stored URL is enough, no provider needed)
+		if post.MediaType == models.MediaTypeImage && post.MediaURL != "" {
 			urn, err = a.publishWithImage(ctx, post)
 		} else {
 			urn, err = a.linkedinClient.CreatePost(ctx, post)
@@ -360,69 +814,388 @@ func (a *Agent) Publish(ctx context.Context, postID uint) (*PublishResult, error
 	return result, nil
 }
 
-// ProcessScheduledPosts publishes all scheduled posts that are due
-func (a *Agent) ProcessScheduledPosts(ctx context.Context) (int, []error) {
-	posts, err := a.repository.GetScheduledPosts(ctx, time.Now())
+// RetryPost republishes a failed post that has attempts left (see Post.CanRetry)
+func (a *Agent) RetryPost(ctx context.Context, postID uint) (*PublishResult, error) {
+	post, err := a.repository.GetPostByID(ctx, postID)
 	if err != nil {
-		return 0, []error{err}
+		return nil, fmt.Errorf("post not found: %w", err)
 	}
 
-	var errors []error
-	published := 0
-
-	for _, post := range posts {
-		result, err := a.Publish(ctx, post.ID)
-		if err != nil {
-			errors = append(errors, fmt.Errorf("post %d: %w", post.ID, err))
-		} else if result.Published {
-			published++
+	if !post.CanRetry() {
+		if post.Status != models.PostStatusFailed {
+			return nil, fmt.Errorf("post %d is %s, only failed posts can be retried", postID, post.Status)
 		}
+		return nil, fmt.Errorf("post %d has used all %d publish attempts", postID, post.RetryCount)
 	}
 
-	return published, errors
+	now := time.Now()
+	post.Status = models.PostStatusScheduled
+	post.ScheduledFor = &now
+	post.ErrorMessage = ""
+	if err := a.repository.UpdatePost(ctx, post); err != nil {
+		return nil, fmt.Errorf("failed to reschedule post: %w", err)
+	}
+
+	a.log.Info().
+		Uint("post_id", postID).
+		Int("retry_count", post.RetryCount).
+		Msg("Retrying failed post")
+
+	return a.Publish(ctx, postID)
 }
 
-// GetTodayPublishCount returns the number of posts published today
-func (a *Agent) GetTodayPublishCount(ctx context.Context) (int, error) {
+// engagementSyncWindow is how long after publishing a post's stats keep being refreshed
+const engagementSyncWindow = 14 * 24 * time.Hour
+
+// RefreshPostStats fetches the latest engagement of a published post from LinkedIn and
+// stores it in the post's AI metadata under "stats"
+func (a *Agent) RefreshPostStats(ctx context.Context, postID uint) (*models.PostStats, error) {
+	post, err := a.repository.GetPostByID(ctx, postID)
+	if err != nil {
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+	if post.LinkedInPostURN == "" {
+		return nil, fmt.Errorf("post %d has not been published to LinkedIn", postID)
+	}
+
+	return a.refreshStats(ctx, post)
+}
+
+// SyncEngagement refreshes the stats of posts published within the last two weeks and
+// writes them to the tracker sheet. Returns the number of posts updated.
+func (a *Agent) SyncEngagement(ctx context.Context) (int, error) {
 	status := models.PostStatusPublished
 	posts, err := a.repository.ListPosts(ctx, storage.PostFilter{
 		Status: &status,
 	})
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("failed to list published posts: %w", err)
 	}
 
-	// Count posts published today
-	today := time.Now().Truncate(24 * time.Hour)
-	count := 0
-	for _, p := range posts {
-		if p.PublishedAt != nil && p.PublishedAt.After(today) {
-			count++
+	cutoff := time.Now().Add(-engagementSyncWindow)
+	synced := 0
+	for _, post := range posts {
+		if post.LinkedInPostURN == "" || post.PublishedAt == nil || post.PublishedAt.Before(cutoff) {
+			continue
 		}
-	}
-	return count, nil
-}
 
-// GetMaxPostsPerDay returns the configured maximum posts per day
-func (a *Agent) GetMaxPostsPerDay() int {
+		stats, err := a.refreshStats(ctx, post)
+		if err != nil {
+			a.log.Warn().Err(err).Uint("post_id", post.ID).Msg("Failed to refresh post stats")
+			continue
+		}
+
+		if a.tracker != nil && post.TopicID != nil {
+			if err := a.tracker.UpdatePostEngagement(ctx, *post.TopicID, stats); err != nil {
+				a.log.Warn().Err(err).Uint("post_id", post.ID).Msg("Failed to update engagement in Google Sheets")
+			}
+		}
+		synced++
+	}
+
+	return synced, nil
+}
+
+// refreshStats fetches a published post's stats and saves them on the post
+func (a *Agent) refreshStats(ctx context.Context, post *models.Post) (*models.PostStats, error) {
+	likes, comments, shares, impressions, err := a.linkedinClient.GetPostStats(ctx, post.LinkedInPostURN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch post stats: %w", err)
+	}
+
+	stats := &models.PostStats{
+		Likes:       likes,
+		Comments:    comments,
+		Shares:      shares,
+		Impressions: impressions,
+		FetchedAt:   time.Now(),
+	}
+
+	if post.AIMetadata == nil {
+		post.AIMetadata = models.JSON{}
+	}
+	post.AIMetadata["stats"] = map[string]interface{}{
+		"likes":       stats.Likes,
+		"comments":    stats.Comments,
+		"shares":      stats.Shares,
+		"impressions": stats.Impressions,
+		"fetched_at":  stats.FetchedAt.Format(time.RFC3339),
+	}
+	if err := a.repository.UpdatePost(ctx, post); err != nil {
+		return nil, fmt.Errorf("failed to save post stats: %w", err)
+	}
+
+	a.log.Info().
+		Uint("post_id", post.ID).
+		Int("likes", stats.Likes).
+		Int("comments", stats.Comments).
+		Msg("Post stats refreshed")
+
+	return stats, nil
+}
+
+// ProcessScheduledPosts publishes all scheduled posts that are due, oldest ScheduledFor first,
+// and retries failed posts that have attempts left once their backoff has passed (see dueForRetry).
+// With PublishParallelism > 1, up to that many posts are published concurrently; the
+// LinkedIn client's rate limiter still paces the underlying API calls. Each post takes one
+// of the day's remaining MaxPostsPerDay slots before it is dispatched, so concurrent
+// workers can't overshoot the limit; posts beyond it wait for the next run.
+func (a *Agent) ProcessScheduledPosts(ctx context.Context) (int, []error) {
+	posts, err := a.repository.GetScheduledPosts(ctx, time.Now())
+	if err != nil {
+		return 0, []error{err}
+	}
+
+	todayCount, err := a.GetTodayPublishCount(ctx)
+	if err != nil {
+		return 0, []error{fmt.Errorf("failed to get today's publish count: %w", err)}
+	}
+	slots := a.config.MaxPostsPerDay - todayCount
+
+	// Failed posts with attempts left are retried (subject to the retry budget below)
+	failedStatus := models.PostStatusFailed
+	failed, err := a.repository.ListPosts(ctx, storage.PostFilter{Status: &failedStatus})
+	if err != nil {
+		a.log.Warn().Err(err).Msg("Failed to list failed posts for retry")
+	}
+	now := time.Now()
+	for _, post := range failed {
+		if post.CanRetry() && a.dueForRetry(post, now) {
+			posts = append(posts, post)
+		}
+	}
+
+	// Publish in schedule order (posts without a schedule time go last)
+	sort.SliceStable(posts, func(i, j int) bool {
+		if posts[i].ScheduledFor == nil {
+			return false
+		}
+		if posts[j].ScheduledFor == nil {
+			return true
+		}
+		return posts[i].ScheduledFor.Before(*posts[j].ScheduledFor)
+	})
+
+	// Fresh posts go first; retries only get the capacity left over for the day
+	posts = a.applyRetryBudget(ctx, posts, slots)
+
+	parallelism := a.config.PublishParallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		errors    []error
+		published int
+	)
+	sem := make(chan struct{}, parallelism)
+
+	for i, post := range posts {
+		sem <- struct{}{}
+
+		// Reserve a slot; a failed publish hands its slot back
+		mu.Lock()
+		if slots <= 0 {
+			mu.Unlock()
+			<-sem
+			a.log.Info().
+				Int("deferred", len(posts)-i).
+				Int("max_per_day", a.config.MaxPostsPerDay).
+				Msg("Daily post limit reached, deferring remaining posts")
+			break
+		}
+		slots--
+		mu.Unlock()
+
+		wg.Add(1)
+		go func(postID uint) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result, err := a.Publish(ctx, postID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errors = append(errors, fmt.Errorf("post %d: %w", postID, err))
+			}
+			if result.Published {
+				published++
+			} else {
+				slots++
+			}
+		}(post.ID)
+	}
+	wg.Wait()
+
+	return published, errors
+}
+
+// dueForRetry reports whether a failed post's backoff has passed and it is still recent
+// enough to publish. Posts past retry_max_age_hours are left for a manual 'publish retry'.
+func (a *Agent) dueForRetry(post *models.Post, now time.Time) bool {
+	if maxAge := time.Duration(a.config.RetryMaxAgeHours) * time.Hour; maxAge > 0 {
+		origin := post.CreatedAt
+		if post.ScheduledFor != nil {
+			origin = *post.ScheduledFor
+		}
+		if now.Sub(origin) > maxAge {
+			return false
+		}
+	}
+
+	backoff := time.Duration(a.config.RetryBackoffMinutes) * time.Minute
+	return !now.Before(post.NextRetryAt(backoff))
+}
+
+// applyRetryBudget orders fresh posts before retries (posts that failed before) and drops
+// retries that would exceed the daily retry cap or eat into the capacity (the posts still
+// allowed today) fresh posts need. Each allowed retry is recorded on its post (see
+// recordRetry), so the cap holds across scheduler restarts and processes sharing storage.
+func (a *Agent) applyRetryBudget(ctx context.Context, posts []*models.Post, capacity int) []*models.Post {
+	var fresh, retries []*models.Post
+	for _, post := range posts {
+		if post.RetryCount > 0 {
+			retries = append(retries, post)
+		} else {
+			fresh = append(fresh, post)
+		}
+	}
+	if len(retries) == 0 {
+		return posts
+	}
+
+	remaining := capacity - len(fresh)
+
+	retriesToday, err := a.countRetriesToday(ctx)
+	if err != nil {
+		a.log.Warn().Err(err).Msg("Failed to count today's retries, deferring retries")
+		return fresh
+	}
+
+	allowed := fresh
+	for _, post := range retries {
+		if remaining <= 0 {
+			a.log.Info().
+				Uint("post_id", post.ID).
+				Msg("Deferring retry: daily capacity reserved for new posts")
+			continue
+		}
+		if a.config.MaxRetryPublishesPerDay > 0 && retriesToday >= a.config.MaxRetryPublishesPerDay {
+			a.log.Info().
+				Uint("post_id", post.ID).
+				Int("max_retries_per_day", a.config.MaxRetryPublishesPerDay).
+				Msg("Deferring retry: daily retry budget exhausted")
+			continue
+		}
+		if err := a.recordRetry(ctx, post); err != nil {
+			a.log.Warn().Err(err).Uint("post_id", post.ID).Msg("Failed to record retry, deferring it")
+			continue
+		}
+		retriesToday++
+		remaining--
+		allowed = append(allowed, post)
+	}
+
+	return allowed
+}
+
+// recordRetry appends the current time to the post's AIMetadata "retried_at" list
+func (a *Agent) recordRetry(ctx context.Context, post *models.Post) error {
+	if post.AIMetadata == nil {
+		post.AIMetadata = models.JSON{}
+	}
+	retriedAt, _ := post.AIMetadata["retried_at"].([]interface{})
+	post.AIMetadata["retried_at"] = append(retriedAt, time.Now().Format(time.RFC3339))
+	return a.repository.UpdatePost(ctx, post)
+}
+
+// countRetriesToday counts the automatic retries recorded on any post since midnight in
+// the publishing time zone. Returns 0 without reading storage when retries are not capped.
+func (a *Agent) countRetriesToday(ctx context.Context) (int, error) {
+	if a.config.MaxRetryPublishesPerDay <= 0 {
+		return 0, nil
+	}
+
+	posts, err := a.repository.ListPosts(ctx, storage.PostFilter{})
+	if err != nil {
+		return 0, err
+	}
+
+	now := a.now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	count := 0
+	for _, p := range posts {
+		retriedAt, _ := p.AIMetadata["retried_at"].([]interface{})
+		for _, v := range retriedAt {
+			s, _ := v.(string)
+			if t, err := time.Parse(time.RFC3339, s); err == nil && !t.Before(today) {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+// GetTodayPublishCount returns the number of posts published today
+func (a *Agent) GetTodayPublishCount(ctx context.Context) (int, error) {
+	status := models.PostStatusPublished
+	posts, err := a.repository.ListPosts(ctx, storage.PostFilter{
+		Status: &status,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// Count posts published since midnight in the publishing time zone
+	now := a.now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	count := 0
+	for _, p := range posts {
+		if p.PublishedAt != nil && p.PublishedAt.After(today) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// GetMaxPostsPerDay returns the configured maximum posts per day
+func (a *Agent) GetMaxPostsPerDay() int {
 	return a.config.MaxPostsPerDay
 }
 
-// SchedulePost schedules a post for future publishing
-func (a *Agent) SchedulePost(ctx context.Context, postID uint, scheduledFor time.Time) error {
+// SchedulePost schedules a post for future publishing and returns the time it will actually
+// be published: the scheduler only publishes during its publish windows, so a post scheduled
+// between windows waits for the next one.
+func (a *Agent) SchedulePost(ctx context.Context, postID uint, scheduledFor time.Time) (time.Time, error) {
 	post, err := a.repository.GetPostByID(ctx, postID)
 	if err != nil {
-		return fmt.Errorf("post not found: %w", err)
+		return time.Time{}, fmt.Errorf("post not found: %w", err)
 	}
 
 	if post.Status == models.PostStatusPublished {
-		return fmt.Errorf("cannot schedule already published post")
+		return time.Time{}, fmt.Errorf("cannot schedule already published post")
 	}
 
 	post.Status = models.PostStatusScheduled
 	post.ScheduledFor = &scheduledFor
 
-	return a.repository.UpdatePost(ctx, post)
+	if err := a.repository.UpdatePost(ctx, post); err != nil {
+		return time.Time{}, err
+	}
+
+	publishAt := a.NextPublishWindow(scheduledFor)
+	if !publishAt.Equal(scheduledFor) {
+		a.log.Info().
+			Uint("post_id", postID).
+			Time("scheduled_for", scheduledFor).
+			Time("publish_at", publishAt).
+			Msg("Scheduled time is outside the publish windows, post will go out in the next window")
+	}
+
+	return publishAt, nil
 }
 
 // ApprovePost approves a draft post for publishing
@@ -444,6 +1217,212 @@ func (a *Agent) ApprovePost(ctx context.Context, postID uint) error {
 	return a.repository.UpdatePost(ctx, post)
 }
 
+// CleanupStaleDrafts deletes drafts older than MaxDraftAgeDays that were never scheduled or published.
+// Returns the number of deleted drafts. Does nothing when MaxDraftAgeDays is 0.
+func (a *Agent) CleanupStaleDrafts(ctx context.Context) (int, error) {
+	if a.config.MaxDraftAgeDays <= 0 {
+		return 0, nil
+	}
+
+	status := models.PostStatusDraft
+	drafts, err := a.repository.ListPosts(ctx, storage.PostFilter{
+		Status: &status,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list drafts: %w", err)
+	}
+
+	cutoff := time.Now().AddDate(0, 0, -a.config.MaxDraftAgeDays)
+	deleted := 0
+
+	for _, post := range drafts {
+		if post.ScheduledFor != nil || post.PublishedAt != nil || !post.CreatedAt.Before(cutoff) {
+			continue
+		}
+
+		if err := a.repository.DeletePost(ctx, post.ID); err != nil {
+			a.log.Warn().Err(err).Uint("post_id", post.ID).Msg("Failed to delete stale draft")
+			continue
+		}
+
+		a.log.Info().
+			Uint("post_id", post.ID).
+			Time("created_at", post.CreatedAt).
+			Int("max_age_days", a.config.MaxDraftAgeDays).
+			Msg("Deleted stale draft")
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+// PruneFailedPosts deletes failed posts that have used all their publish attempts and
+// were last updated before cutoff. Returns the number deleted.
+func (a *Agent) PruneFailedPosts(ctx context.Context, cutoff time.Time) (int, error) {
+	status := models.PostStatusFailed
+	failed, err := a.repository.ListPosts(ctx, storage.PostFilter{
+		Status: &status,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list failed posts: %w", err)
+	}
+
+	deleted := 0
+	for _, post := range failed {
+		if post.CanRetry() || !post.UpdatedAt.Before(cutoff) {
+			continue
+		}
+
+		if err := a.repository.DeletePost(ctx, post.ID); err != nil {
+			a.log.Warn().Err(err).Uint("post_id", post.ID).Msg("Failed to delete failed post")
+			continue
+		}
+
+		a.log.Info().
+			Uint("post_id", post.ID).
+			Int("retry_count", post.RetryCount).
+			Msg("Deleted failed post past its retry limit")
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+// pollDurationDays maps a LinkedIn poll duration (PostFormat["duration"]) to days.
+// Unknown or empty values fall back to LinkedIn's three-day default.
+func pollDurationDays(duration string) int {
+	switch duration {
+	case "ONE_DAY":
+		return 1
+	case "ONE_WEEK":
+		return 7
+	case "TWO_WEEKS":
+		return 14
+	default:
+		return 3
+	}
+}
+
+// pollDurationSetting maps a poll duration in days to the LinkedIn duration stored in
+// PostFormat["duration"]. LinkedIn only supports 1, 3, 7 and 14 days; 0 means the default.
+func pollDurationSetting(days int) (string, error) {
+	switch days {
+	case 1:
+		return "ONE_DAY", nil
+	case 0, 3:
+		return "THREE_DAYS", nil
+	case 7:
+		return "ONE_WEEK", nil
+	case 14:
+		return "TWO_WEEKS", nil
+	default:
+		return "", fmt.Errorf("unsupported poll duration %d days (use 1, 3, 7 or 14)", days)
+	}
+}
+
+// maxPollResultAttempts is how many failed fetches of a closed poll's results are tried
+// before giving up on it (e.g. the poll was deleted on LinkedIn)
+const maxPollResultAttempts = 5
+
+// pollResultsMaxAge is how long after a poll closes its results are still fetched
+const pollResultsMaxAge = 30 * 24 * time.Hour
+
+// CollectPollResults fetches the final vote distribution of published polls whose duration
+// has elapsed and stores it in the post's AIMetadata ("poll_results") and the tracker.
+// Failed fetches are counted in "poll_results_attempts"; a poll is given up on after
+// maxPollResultAttempts failures or once it closed more than pollResultsMaxAge ago.
+// Returns the number of polls whose results were recorded.
+func (a *Agent) CollectPollResults(ctx context.Context) (int, error) {
+	status := models.PostStatusPublished
+	posts, err := a.repository.ListPosts(ctx, storage.PostFilter{
+		Status: &status,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list published posts: %w", err)
+	}
+
+	collected := 0
+	for _, post := range posts {
+		if post.PostType != models.PostTypePoll || post.LinkedInPostURN == "" || post.PublishedAt == nil {
+			continue
+		}
+		if _, done := post.AIMetadata["poll_results"]; done {
+			continue
+		}
+		if abandoned, _ := post.AIMetadata["poll_results_abandoned"].(bool); abandoned {
+			continue
+		}
+
+		duration, _ := post.PostFormat["duration"].(string)
+		closesAt := post.PublishedAt.AddDate(0, 0, pollDurationDays(duration))
+		if time.Now().Before(closesAt) || time.Since(closesAt) > pollResultsMaxAge {
+			continue
+		}
+
+		if post.AIMetadata == nil {
+			post.AIMetadata = models.JSON{}
+		}
+
+		results, err := a.linkedinClient.GetPollResults(ctx, post.LinkedInPostURN)
+		if err != nil {
+			attempts := pollResultAttempts(post) + 1
+			post.AIMetadata["poll_results_attempts"] = attempts
+			if attempts >= maxPollResultAttempts {
+				post.AIMetadata["poll_results_abandoned"] = true
+			}
+			a.log.Warn().
+				Err(err).
+				Uint("post_id", post.ID).
+				Int("attempt", attempts).
+				Int("max_attempts", maxPollResultAttempts).
+				Msg("Failed to fetch poll results")
+			if err := a.repository.UpdatePost(ctx, post); err != nil {
+				a.log.Warn().Err(err).Uint("post_id", post.ID).Msg("Failed to save poll result attempts")
+			}
+			continue
+		}
+
+		votes := make(map[string]interface{}, len(results.Options))
+		summary := make([]string, len(results.Options))
+		for i, opt := range results.Options {
+			votes[opt.Text] = opt.VoteCount
+			summary[i] = fmt.Sprintf("%s: %d", opt.Text, opt.VoteCount)
+		}
+
+		post.AIMetadata["poll_results"] = votes
+		post.AIMetadata["poll_unique_voters"] = results.UniqueVoters
+		post.AIMetadata["poll_closed_at"] = closesAt.Format(time.RFC3339)
+		if err := a.repository.UpdatePost(ctx, post); err != nil {
+			a.log.Warn().Err(err).Uint("post_id", post.ID).Msg("Failed to save poll results")
+			continue
+		}
+
+		if a.tracker != nil && post.TopicID != nil {
+			a.tracker.UpdatePollResults(ctx, *post.TopicID, strings.Join(summary, "; "))
+		}
+
+		a.log.Info().
+			Uint("post_id", post.ID).
+			Int("unique_voters", results.UniqueVoters).
+			Msg("Poll results recorded")
+		collected++
+	}
+
+	return collected, nil
+}
+
+// pollResultAttempts returns how many times fetching a poll's results has failed. The
+// count is stored as a number in AIMetadata, which reads back from JSON as a float64.
+func pollResultAttempts(post *models.Post) int {
+	switch n := post.AIMetadata["poll_results_attempts"].(type) {
+	case int:
+		return n
+	case float64:
+		return int(n)
+	}
+	return 0
+}
+
 // DigestResult contains the result of digest generation
 type DigestResult struct {
 	Post      *models.Post
@@ -451,15 +1430,69 @@ type DigestResult struct {
 	TopicIDs  []uint
 }
 
+// DigestTopicStatuses are the topic statuses a digest may draw from: topics still awaiting
+// review as well as manually approved ones. Rejected and already used topics never qualify.
+var DigestTopicStatuses = []models.TopicStatus{models.TopicStatusPending, models.TopicStatusApproved}
+
+// digestCandidatePool is how many top topics are considered when picking digest stories,
+// so the per-source cap can reach past a dominant source
+const digestCandidatePool = 30
+
+// RankByFreshness re-sorts topics by their score decayed for age since discovery: a topic
+// halfLifeHours old counts half its AIScore, so yesterday's high scorer doesn't beat today's
+// news forever. A non-positive halfLifeHours keeps the order unchanged.
+func RankByFreshness(topics []*models.Topic, halfLifeHours float64) []*models.Topic {
+	if halfLifeHours <= 0 {
+		return topics
+	}
+
+	now := time.Now()
+	effective := make(map[*models.Topic]float64, len(topics))
+	for _, topic := range topics {
+		age := now.Sub(topic.DiscoveredAt).Hours()
+		if age < 0 {
+			age = 0
+		}
+		effective[topic] = topic.AIScore * math.Pow(0.5, age/halfLifeHours)
+	}
+
+	sort.SliceStable(topics, func(i, j int) bool {
+		return effective[topics[i]] > effective[topics[j]]
+	})
+	return topics
+}
+
+// CapTopicsPerSource picks up to n topics in ranked order, taking at most maxPerSource
+// from any single source. A non-positive maxPerSource disables the cap.
+func CapTopicsPerSource(topics []*models.Topic, n, maxPerSource int) []*models.Topic {
+	selected := make([]*models.Topic, 0, n)
+	perSource := make(map[string]int)
+
+	for _, topic := range topics {
+		if len(selected) >= n {
+			break
+		}
+		if maxPerSource > 0 && perSource[topic.SourceName] >= maxPerSource {
+			continue
+		}
+		perSource[topic.SourceName]++
+		selected = append(selected, topic)
+	}
+
+	return selected
+}
+
 // GenerateDigest creates a daily digest post from the top 3 trending topics
 func (a *Agent) GenerateDigest(ctx context.Context) (*DigestResult, error) {
 	a.log.Info().Msg("Generating daily tech news digest")
 
-	// Get top 3 approved topics by score
-	topics, err := a.repository.GetTopTopics(ctx, 3, a.config.MinScoreThreshold)
+	// Get top 3 pending or approved topics by score, limiting how many come from one source
+	topics, err := a.repository.GetTopTopics(ctx, digestCandidatePool, a.config.MinScoreThreshold, DigestTopicStatuses)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get top topics: %w", err)
 	}
+	topics = RankByFreshness(topics, a.config.FreshnessHalfLifeHours)
+	topics = CapTopicsPerSource(topics, 3, a.config.MaxTopicsPerSourceInDigest)
 
 	if len(topics) < 3 {
 		return nil, fmt.Errorf("not enough topics for digest (need 3, got %d)", len(topics))
@@ -478,23 +1511,32 @@ func (a *Agent) GenerateDigest(ctx context.Context) (*DigestResult, error) {
 	}
 
 	// Generate digest content
-	digest, err := a.aiClient.GenerateDigest(ctx, digestTopics, a.config.BrandVoice)
+	digest, err := a.aiClient.GenerateDigest(ctx, digestTopics, a.config.BrandVoice, a.config.Author)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate digest: %w", err)
 	}
 
-	// Append hashtags to content
-	fullContent := digest.Content
-	if len(digest.Hashtags) > 0 {
-		fullContent += "\n\n"
-		for _, tag := range digest.Hashtags {
-			if tag[0] != '#' {
-				fullContent += "#"
-			}
-			fullContent += tag + " "
+	// Regenerate once if the header plus hook pushes the hook past the "see more" fold
+	hookFits := ai.HookFitsFold(digest.Content, a.config.HookFoldLength)
+	if !hookFits {
+		a.log.Warn().
+			Int("fold_length", a.config.HookFoldLength).
+			Msg("Digest hook extends past the fold, regenerating digest")
+		retry, err := a.aiClient.GenerateDigest(ctx, digestTopics, a.config.BrandVoice, a.config.Author)
+		if err != nil {
+			a.log.Warn().Err(err).Msg("Failed to regenerate digest, keeping original")
+		} else {
+			digest = retry
+			hookFits = ai.HookFitsFold(digest.Content, a.config.HookFoldLength)
+		}
+		if !hookFits {
+			a.log.Warn().Msg("Digest hook still extends past the fold, review before publishing")
 		}
 	}
 
+	// Append hashtags not already present in the content
+	fullContent := ai.AppendHashtags(digest.Content, digest.Hashtags)
+
 	// Create post (link to first topic for tracking)
 	post := &models.Post{
 		TopicID:          &topicIDs[0],
@@ -502,17 +1544,22 @@ func (a *Agent) GenerateDigest(ctx context.Context) (*DigestResult, error) {
 		PostType:         models.PostTypeText,
 		GenerationPrompt: "Daily tech news digest - top 3 stories",
 		AIMetadata: models.JSON{
-			"hook":      digest.Hook,
-			"cta":       digest.CTA,
-			"hashtags":  digest.Hashtags,
-			"topic_ids": topicIDs,
-			"is_digest": true,
+			"hook":           digest.Hook,
+			"cta":            digest.CTA,
+			"hashtags":       digest.Hashtags,
+			"topic_ids":      topicIDs,
+			"is_digest":      true,
+			"hook_fits_fold": hookFits,
 		},
 		Status: models.PostStatusDraft,
 	}
+	if digest.Raw != nil {
+		post.AIMetadata["raw_response"] = digest.Raw
+	}
+	a.flagStatistics(post)
 
 	// Attach image if media is enabled (use first/top topic for image keywords)
-	if a.mediaConfig.Enabled && a.unsplashClient != nil {
+	if a.imagesEnabled() {
 		if err := a.AttachImageToPost(ctx, post, topics[0]); err != nil {
 			a.log.Warn().Err(err).Msg("Failed to attach image to digest, will publish as text-only")
 		}
@@ -545,8 +1592,237 @@ func (a *Agent) GenerateDigest(ctx context.Context) (*DigestResult, error) {
 	}, nil
 }
 
-// downloadImageFromURL downloads an image from a URL and returns the raw bytes
+// weeklyRecapSize is the number of published stories featured in a weekly recap
+const weeklyRecapSize = 5
+
+// GenerateWeeklyRecap creates a "week in tech" recap post from the highest-scoring
+// posts published in the past 7 days
+func (a *Agent) GenerateWeeklyRecap(ctx context.Context) (*DigestResult, error) {
+	a.log.Info().Msg("Generating weekly recap")
+
+	status := models.PostStatusPublished
+	posts, err := a.repository.ListPosts(ctx, storage.PostFilter{Status: &status})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list published posts: %w", err)
+	}
+
+	// Collect this week's posts (excluding earlier recaps) with their topics
+	type candidate struct {
+		post  *models.Post
+		topic *models.Topic
+	}
+	weekAgo := time.Now().AddDate(0, 0, -7)
+	var candidates []candidate
+	for _, post := range posts {
+		if post.PublishedAt == nil || post.PublishedAt.Before(weekAgo) || post.TopicID == nil {
+			continue
+		}
+		if isRecap, _ := post.AIMetadata["is_weekly_recap"].(bool); isRecap {
+			continue
+		}
+
+		topic := post.Topic
+		if topic == nil {
+			if topic, err = a.repository.GetTopicByID(ctx, *post.TopicID); err != nil || topic == nil {
+				continue
+			}
+		}
+		candidates = append(candidates, candidate{post: post, topic: topic})
+	}
+
+	if len(candidates) < 3 {
+		return nil, fmt.Errorf("not enough published posts this week for a recap (need 3, got %d)", len(candidates))
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].topic.AIScore > candidates[j].topic.AIScore
+	})
+	if len(candidates) > weeklyRecapSize {
+		candidates = candidates[:weeklyRecapSize]
+	}
+
+	stories := make([]ai.DigestTopic, len(candidates))
+	postIDs := make([]uint, len(candidates))
+	topicIDs := make([]uint, len(candidates))
+	for i, c := range candidates {
+		stories[i] = ai.DigestTopic{
+			Title:       c.topic.Title,
+			Description: ai.ExtractHook(c.post.Content),
+			Source:      c.topic.SourceName,
+		}
+		postIDs[i] = c.post.ID
+		topicIDs[i] = c.topic.ID
+	}
+
+	recap, err := a.aiClient.GenerateWeeklyRecap(ctx, stories, a.config.BrandVoice, a.config.Author)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate weekly recap: %w", err)
+	}
+
+	// No TopicID: the recap covers several published stories (linked through topic_ids and
+	// post_ids), and tying it to one would mark that topic as used by the recap too
+	post := &models.Post{
+		Content:          ai.AppendHashtags(recap.Content, recap.Hashtags),
+		PostType:         models.PostTypeText,
+		GenerationPrompt: "Weekly tech recap - top published stories",
+		AIMetadata: models.JSON{
+			"hook":            recap.Hook,
+			"cta":             recap.CTA,
+			"hashtags":        recap.Hashtags,
+			"topic_ids":       topicIDs,
+			"post_ids":        postIDs,
+			"is_weekly_recap": true,
+			"hook_fits_fold":  ai.HookFitsFold(recap.Content, a.config.HookFoldLength),
+		},
+		Status: models.PostStatusDraft,
+	}
+	if recap.Raw != nil {
+		post.AIMetadata["raw_response"] = recap.Raw
+	}
+	a.flagStatistics(post)
+
+	if err := a.repository.CreatePost(ctx, post); err != nil {
+		return nil, fmt.Errorf("failed to save weekly recap post: %w", err)
+	}
+
+	a.log.Info().
+		Uint("post_id", post.ID).
+		Uints("source_post_ids", postIDs).
+		Msg("Weekly recap generated")
+
+	return &DigestResult{
+		Post:     post,
+		Preview:  post.Content,
+		TopicIDs: topicIDs,
+	}, nil
+}
+
+// GenerateArticle creates a long-form article draft from a cluster of topics. The post content
+// is the feed teaser; the article title, subtitle and body are stored in PostFormat.
+func (a *Agent) GenerateArticle(ctx context.Context, topicIDs []uint, articleURL string) (*GenerateResult, error) {
+	if len(topicIDs) == 0 {
+		return nil, fmt.Errorf("at least one topic is required")
+	}
+
+	topics := make([]ai.DigestTopic, 0, len(topicIDs))
+	for _, id := range topicIDs {
+		topic, err := a.repository.GetTopicByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("topic %d not found: %w", id, err)
+		}
+		topics = append(topics, ai.DigestTopic{
+			Title:       topic.Title,
+			Description: topic.Description,
+			Source:      topic.SourceName,
+		})
+	}
+
+	a.log.Info().
+		Uints("topic_ids", topicIDs).
+		Msg("Generating long-form article")
+
+	article, err := a.aiClient.GenerateArticle(ctx, topics, a.config.BrandVoice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate article: %w", err)
+	}
+
+	post := newArticlePost(article, topicIDs, articleURL)
+	if err := a.repository.CreatePost(ctx, post); err != nil {
+		return nil, fmt.Errorf("failed to save article: %w", err)
+	}
+
+	a.log.Info().
+		Uint("post_id", post.ID).
+		Str("title", article.Title).
+		Msg("Article generated")
+
+	return &GenerateResult{
+		Post:    post,
+		Preview: articlePreview(article),
+	}, nil
+}
+
+// newArticlePost builds an article draft: the content is the feed teaser and the article
+// title, subtitle and body are stored in PostFormat
+func newArticlePost(article *ai.GeneratedArticle, topicIDs []uint, articleURL string) *models.Post {
+	post := &models.Post{
+		TopicID:          &topicIDs[0],
+		Content:          ai.AppendHashtags(article.Teaser, article.Hashtags),
+		PostType:         models.PostTypeArticle,
+		GenerationPrompt: fmt.Sprintf("Long-form article from %d topics", len(topicIDs)),
+		PostFormat: models.JSON{
+			"title":    article.Title,
+			"subtitle": article.Subtitle,
+			"body":     article.Body(),
+			"url":      articleURL,
+		},
+		AIMetadata: models.JSON{
+			"hashtags":  article.Hashtags,
+			"topic_ids": topicIDs,
+			"sections":  len(article.Sections),
+		},
+		Status: models.PostStatusDraft,
+	}
+	if article.Raw != nil {
+		post.AIMetadata["raw_response"] = article.Raw
+	}
+	return post
+}
+
+// articlePreview renders the article title, subtitle and body for review
+func articlePreview(article *ai.GeneratedArticle) string {
+	return article.Title + "\n" + article.Subtitle + "\n\n" + article.Body()
+}
+
+// SetArticleURL records where an article draft's body was published, so its feed post can link to it
+func (a *Agent) SetArticleURL(ctx context.Context, postID uint, articleURL string) error {
+	post, err := a.repository.GetPostByID(ctx, postID)
+	if err != nil {
+		return fmt.Errorf("post not found: %w", err)
+	}
+	if post.PostType != models.PostTypeArticle {
+		return fmt.Errorf("post %d is not an article", postID)
+	}
+
+	if post.PostFormat == nil {
+		post.PostFormat = models.JSON{}
+	}
+	post.PostFormat["url"] = articleURL
+	return a.repository.UpdatePost(ctx, post)
+}
+
+// downloadImageFromURL downloads an image from a URL (or reads a file:// image) and returns the raw bytes
 func (a *Agent) downloadImageFromURL(ctx context.Context, imageURL string) ([]byte, error) {
+	var data []byte
+	var err error
+	if path, ok := strings.CutPrefix(imageURL, "file://"); ok {
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read local image: %w", err)
+		}
+	} else {
+		data, err = fetchImage(ctx, imageURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Reject error pages and other non-image responses before they reach LinkedIn
+	contentType, err := linkedin.ImageContentType(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image from %s: %w", imageURL, err)
+	}
+
+	a.log.Info().
+		Int("size_bytes", len(data)).
+		Str("content_type", contentType).
+		Msg("Image downloaded successfully")
+
+	return data, nil
+}
+
+// fetchImage downloads the raw bytes of an image over HTTP
+func fetchImage(ctx context.Context, imageURL string) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", imageURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -567,10 +1843,5 @@ func (a *Agent) downloadImageFromURL(ctx context.Context, imageURL string) ([]by
 	if err != nil {
 		return nil, fmt.Errorf("failed to read image data: %w", err)
 	}
-
-	a.log.Info().
-		Int("size_bytes", len(data)).
-		Msg("Image downloaded successfully")
-
 	return data, nil
 }
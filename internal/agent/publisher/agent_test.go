@@ -0,0 +1,272 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkedin-agent/internal/config"
+	"github.com/linkedin-agent/internal/linkedin"
+	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/internal/storage/sqlite"
+	"github.com/linkedin-agent/pkg/logger"
+	"github.com/linkedin-agent/pkg/ratelimit"
+)
+
+// fakeLinkedIn accepts every post and records the commentary of each, in arrival order.
+// Any other request gets a 404 and is counted in notFound.
+type fakeLinkedIn struct {
+	mu       sync.Mutex
+	posts    []string
+	notFound int
+}
+
+func (f *fakeLinkedIn) published() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.posts...)
+}
+
+func newTestAgent(t *testing.T, cfg config.PublishingConfig) (*Agent, *sqlite.Repository, *fakeLinkedIn) {
+	t.Helper()
+
+	repo, err := sqlite.New(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { repo.Close() })
+	if err := repo.Migrate(); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	fake := &fakeLinkedIn{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/userinfo":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]string{"sub": "abc123"})
+		case "POST /v2/posts":
+			var body struct {
+				Commentary string `json:"commentary"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+
+			fake.mu.Lock()
+			fake.posts = append(fake.posts, body.Commentary)
+			n := len(fake.posts)
+			fake.mu.Unlock()
+
+			w.Header().Set("x-restli-id", fmt.Sprintf("urn:li:share:%d", n))
+			w.WriteHeader(http.StatusCreated)
+		default:
+			fake.mu.Lock()
+			fake.notFound++
+			fake.mu.Unlock()
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	log := logger.New(logger.Config{Level: "error"})
+	oauth := linkedin.NewOAuthManagerEnvOnly(config.LinkedInConfig{AccessToken: "test-token"}, log)
+	limiter := ratelimit.NewMultiLimiter()
+	limiter.AddLimiter(ratelimit.LimiterLinkedIn, 1000, 100)
+	client := linkedin.NewClient(oauth, limiter, log, linkedin.WithBaseURL(server.URL))
+
+	return NewAgent(nil, client, repo, cfg, log), repo, fake
+}
+
+// schedulePosts creates one scheduled post per content, due minutesAgo[i] minutes ago
+func schedulePosts(t *testing.T, repo *sqlite.Repository, contents []string, minutesAgo []int) {
+	t.Helper()
+
+	for i, content := range contents {
+		scheduledFor := time.Now().Add(-time.Duration(minutesAgo[i]) * time.Minute)
+		post := &models.Post{Content: content, Status: models.PostStatusScheduled, ScheduledFor: &scheduledFor}
+		if err := repo.CreatePost(context.Background(), post); err != nil {
+			t.Fatalf("CreatePost: %v", err)
+		}
+	}
+}
+
+func TestProcessScheduledPostsPublishesInScheduleOrder(t *testing.T) {
+	agent, repo, fake := newTestAgent(t, config.PublishingConfig{MaxPostsPerDay: 10, PublishParallelism: 1})
+	// Created out of order: the oldest schedule time must still go out first
+	schedulePosts(t, repo, []string{"second", "third", "first"}, []int{20, 10, 30})
+
+	published, errs := agent.ProcessScheduledPosts(context.Background())
+	if len(errs) > 0 {
+		t.Fatalf("ProcessScheduledPosts errors: %v", errs)
+	}
+	if published != 3 {
+		t.Errorf("published %d posts, want 3", published)
+	}
+
+	got := fake.published()
+	want := []string{"first", "second", "third"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("publish order = %v, want %v", got, want)
+	}
+}
+
+func TestProcessScheduledPostsParallelRespectsDailyLimit(t *testing.T) {
+	agent, repo, fake := newTestAgent(t, config.PublishingConfig{MaxPostsPerDay: 2, PublishParallelism: 4})
+	schedulePosts(t, repo, []string{"a", "b", "c", "d", "e"}, []int{5, 4, 3, 2, 1})
+
+	published, errs := agent.ProcessScheduledPosts(context.Background())
+	if len(errs) > 0 {
+		t.Fatalf("ProcessScheduledPosts errors: %v", errs)
+	}
+	if published != 2 {
+		t.Errorf("published %d posts, want the daily limit of 2", published)
+	}
+	if n := len(fake.published()); n != 2 {
+		t.Errorf("LinkedIn received %d posts, want 2", n)
+	}
+
+	// The limit is already reached, so another run publishes nothing
+	published, _ = agent.ProcessScheduledPosts(context.Background())
+	if published != 0 {
+		t.Errorf("second run published %d posts, want 0", published)
+	}
+}
+
+func TestDueForRetry(t *testing.T) {
+	agent := &Agent{config: config.PublishingConfig{RetryBackoffMinutes: 30, RetryMaxAgeHours: 24}}
+	now := time.Now()
+	scheduled := now.Add(-2 * time.Hour)
+	stale := now.Add(-25 * time.Hour)
+
+	tests := []struct {
+		name       string
+		failedAgo  time.Duration
+		retryCount int
+		scheduled  *time.Time
+		want       bool
+	}{
+		{"first failure still backing off", 10 * time.Minute, 1, &scheduled, false},
+		{"first failure after backoff", 31 * time.Minute, 1, &scheduled, true},
+		{"second failure waits twice as long", 45 * time.Minute, 2, &scheduled, false},
+		{"second failure after doubled backoff", 61 * time.Minute, 2, &scheduled, true},
+		{"scheduled too long ago", 2 * time.Hour, 1, &stale, false},
+	}
+	for _, tt := range tests {
+		post := &models.Post{
+			Status:       models.PostStatusFailed,
+			RetryCount:   tt.retryCount,
+			ScheduledFor: tt.scheduled,
+			UpdatedAt:    now.Add(-tt.failedAgo),
+		}
+		if got := agent.dueForRetry(post, now); got != tt.want {
+			t.Errorf("%s: dueForRetry = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCapTopicsPerSourceWithDominantSource(t *testing.T) {
+	var topics []*models.Topic
+	for i := 1; i <= 8; i++ {
+		topics = append(topics, &models.Topic{Title: fmt.Sprintf("hn %d", i), SourceName: "Hacker News"})
+	}
+	topics = append(topics,
+		&models.Topic{Title: "tc", SourceName: "TechCrunch"},
+		&models.Topic{Title: "verge", SourceName: "The Verge"},
+	)
+
+	titles := func(topics []*models.Topic) string {
+		var s []string
+		for _, topic := range topics {
+			s = append(s, topic.Title)
+		}
+		return fmt.Sprint(s)
+	}
+
+	tests := []struct {
+		name         string
+		topics       []*models.Topic
+		maxPerSource int
+		want         string
+	}{
+		{"cap reaches past the dominant source", topics, 2, "[hn 1 hn 2 tc]"},
+		{"cap of one spreads across sources", topics, 1, "[hn 1 tc verge]"},
+		{"no cap keeps ranked order", topics, 0, "[hn 1 hn 2 hn 3]"},
+		{"single source can't fill the digest", topics[:8], 2, "[hn 1 hn 2]"},
+	}
+	for _, tt := range tests {
+		if got := titles(CapTopicsPerSource(tt.topics, 3, tt.maxPerSource)); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCollectPollResultsGivesUpAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	agent, repo, fake := newTestAgent(t, config.PublishingConfig{})
+
+	// A one-day poll that closed yesterday and has since been deleted on LinkedIn
+	publishedAt := time.Now().Add(-48 * time.Hour)
+	poll := &models.Post{
+		Content:         "Tabs or spaces?",
+		PostType:        models.PostTypePoll,
+		PostFormat:      models.JSON{"duration": "ONE_DAY"},
+		Status:          models.PostStatusPublished,
+		LinkedInPostURN: "urn:li:share:1",
+		PublishedAt:     &publishedAt,
+	}
+	if err := repo.CreatePost(ctx, poll); err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+
+	for run := 0; run < maxPollResultAttempts+2; run++ {
+		if _, err := agent.CollectPollResults(ctx); err != nil {
+			t.Fatalf("CollectPollResults: %v", err)
+		}
+	}
+
+	fake.mu.Lock()
+	fetches := fake.notFound
+	fake.mu.Unlock()
+	if fetches != maxPollResultAttempts {
+		t.Errorf("poll results fetched %d times, want %d", fetches, maxPollResultAttempts)
+	}
+
+	stored, err := repo.GetPostByID(ctx, poll.ID)
+	if err != nil {
+		t.Fatalf("GetPostByID: %v", err)
+	}
+	if abandoned, _ := stored.AIMetadata["poll_results_abandoned"].(bool); !abandoned {
+		t.Errorf("poll not marked as abandoned after %d failed fetches", maxPollResultAttempts)
+	}
+}
+
+func TestRetryBudgetSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.PublishingConfig{MaxPostsPerDay: 10, MaxRetryPublishesPerDay: 1}
+	agent, repo, fake := newTestAgent(t, cfg)
+
+	for _, content := range []string{"failed once", "failed twice"} {
+		post := &models.Post{Content: content, Status: models.PostStatusFailed, RetryCount: 1}
+		if err := repo.CreatePost(ctx, post); err != nil {
+			t.Fatalf("CreatePost: %v", err)
+		}
+	}
+
+	if published, _ := agent.ProcessScheduledPosts(ctx); published != 1 {
+		t.Fatalf("first run published %d retries, want 1", published)
+	}
+
+	// A new agent, as after a scheduler restart, sees the retry already made today
+	restarted := NewAgent(nil, agent.linkedinClient, repo, cfg, logger.New(logger.Config{Level: "error"}))
+	if published, _ := restarted.ProcessScheduledPosts(ctx); published != 0 {
+		t.Errorf("run after restart published %d retries, want 0", published)
+	}
+	if n := len(fake.published()); n != 1 {
+		t.Errorf("LinkedIn received %d posts, want 1", n)
+	}
+}
@@ -2,57 +2,247 @@ package ai
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"sync"
+	"time"
 
 	"github.com/anthropics/anthropic-sdk-go"
 	"github.com/anthropics/anthropic-sdk-go/option"
 
 	"github.com/linkedin-agent/internal/config"
+	"github.com/linkedin-agent/internal/models"
 	"github.com/linkedin-agent/pkg/logger"
 	"github.com/linkedin-agent/pkg/ratelimit"
 )
 
 // Client wraps the Anthropic SDK client
 type Client struct {
-	client      anthropic.Client
-	model       string
-	maxTokens   int
-	temperature float64
-	rateLimiter *ratelimit.MultiLimiter
-	log         *logger.Logger
+	client       anthropic.Client
+	model        string
+	maxTokens    int
+	temperature  float64
+	rankingTemp  *float64 // nil = use temperature
+	contentTemp  *float64
+	rankingModel string
+	contentModel string
+	rankBatch    int
+	saveRaw      bool
+	retryJSON    bool
+	rateLimiter  *ratelimit.MultiLimiter
+	log          *logger.Logger
+
+	// Daily caps (0 = unlimited)
+	maxRequestsPerDay int
+	maxTokensPerDay   int64
+
+	// Token accounting (in-memory; the daily counters reset at midnight)
+	usageMu    sync.Mutex
+	usageTotal Usage
+	usageDay   string
+	usageToday Usage
+
+	// Shared daily usage; when set, the daily caps count every process's calls
+	usageStore UsageStore
+}
+
+// UsageStore persists each day's Claude usage. storage.Repository implements it.
+type UsageStore interface {
+	AddAIUsage(ctx context.Context, usage *models.AIUsage) error
+	GetAIUsage(ctx context.Context, day string) (*models.AIUsage, error)
+}
+
+// ErrBudgetExceeded is returned by Complete once the daily request or token cap is reached
+var ErrBudgetExceeded = errors.New("daily Claude budget exceeded")
+
+// ErrResponseTruncated is returned by Complete when the response stopped at max_tokens
+var ErrResponseTruncated = errors.New("claude response truncated at max_tokens")
+
+// API errors returned by Complete wrap one of these by HTTP status, so callers can tell
+// transient failures from permanent ones
+var (
+	ErrRateLimited = errors.New("claude API rate limit reached")    // 429
+	ErrOverloaded  = errors.New("claude API overloaded")            // 529
+	ErrAuth        = errors.New("claude API authentication failed") // 401, 403
+)
+
+// IsTransient reports whether err is a rate-limit or overload error that may succeed if retried later
+func IsTransient(err error) bool {
+	return errors.Is(err, ErrRateLimited) || errors.Is(err, ErrOverloaded)
+}
+
+// apiError wraps an SDK error with the matching typed error, if any
+func apiError(err error) error {
+	var sdkErr *anthropic.Error
+	if errors.As(err, &sdkErr) {
+		switch sdkErr.StatusCode {
+		case http.StatusTooManyRequests:
+			return fmt.Errorf("%w: %w", ErrRateLimited, err)
+		case 529:
+			return fmt.Errorf("%w: %w", ErrOverloaded, err)
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return fmt.Errorf("%w: %w", ErrAuth, err)
+		}
+	}
+	return fmt.Errorf("claude API error: %w", err)
+}
+
+// maxTruncationRetryTokens caps the max_tokens of the retry after a truncated JSON response
+const maxTruncationRetryTokens = 16384
+
+// Usage holds the request and token counts of Claude calls
+type Usage struct {
+	Requests     int
+	InputTokens  int64
+	OutputTokens int64
+}
+
+// Cost estimates the spend in USD from per-million-token input and output prices
+func (u Usage) Cost(inputPricePerMTok, outputPricePerMTok float64) float64 {
+	return float64(u.InputTokens)/1e6*inputPricePerMTok + float64(u.OutputTokens)/1e6*outputPricePerMTok
+}
+
+// RawResponse captures the prompts and unparsed response of a generation call for debugging
+type RawResponse struct {
+	SystemPrompt string `json:"system_prompt"`
+	UserPrompt   string `json:"user_prompt"`
+	Response     string `json:"response"`
 }
 
 // NewClient creates a new Anthropic client
 func NewClient(cfg config.AnthropicConfig, limiter *ratelimit.MultiLimiter, log *logger.Logger) *Client {
-	client := anthropic.NewClient(
-		option.WithAPIKey(cfg.APIKey),
-	)
+	opts := []option.RequestOption{option.WithAPIKey(cfg.APIKey)}
+	if cfg.BaseURL != "" {
+		opts = append(opts, option.WithBaseURL(cfg.BaseURL))
+	}
+	client := anthropic.NewClient(opts...)
 
 	return &Client{
-		client:      client,
-		model:       cfg.Model,
-		maxTokens:   cfg.MaxTokens,
-		temperature: cfg.Temperature,
-		rateLimiter: limiter,
-		log:         log.WithComponent("ai"),
+		client:       client,
+		model:        cfg.Model,
+		maxTokens:    cfg.MaxTokens,
+		temperature:  cfg.Temperature,
+		rankingTemp:  cfg.RankingTemperature,
+		contentTemp:  cfg.ContentTemperature,
+		rankingModel: cfg.RankingModel,
+		contentModel: cfg.ContentModel,
+		rankBatch:    cfg.RankBatchSize,
+		saveRaw:      cfg.SaveRawResponses,
+		retryJSON:    cfg.RetryInvalidJSON,
+		rateLimiter:  limiter,
+		log:          log.WithComponent("ai"),
+
+		maxRequestsPerDay: cfg.MaxRequestsPerDay,
+		maxTokensPerDay:   int64(cfg.MaxTokensPerDay),
+	}
+}
+
+// SetUsageStore makes the daily caps count the usage recorded in store, so the budget
+// holds across restarts and across the scheduler and CLI running side by side
+func (c *Client) SetUsageStore(store UsageStore) {
+	c.usageStore = store
+}
+
+// completeParams holds per-request overrides for Complete
+type completeParams struct {
+	model       string
+	temperature float64
+	maxTokens   int
+}
+
+// CompleteOption overrides a request parameter for a single Complete call
+type CompleteOption func(*completeParams)
+
+// WithTemperature sets the sampling temperature for a single request. 0 is honored, for
+// deterministic output.
+func WithTemperature(temperature float64) CompleteOption {
+	return func(p *completeParams) {
+		p.temperature = temperature
+	}
+}
+
+// taskOpts returns the request options of a task with its own model and temperature
+// overrides, leaving out the unset ones so they keep the configured defaults
+func taskOpts(model string, temperature *float64) []CompleteOption {
+	opts := []CompleteOption{WithModel(model)}
+	if temperature != nil {
+		opts = append(opts, WithTemperature(*temperature))
+	}
+	return opts
+}
+
+// WithMaxTokens sets the response token limit for a single request.
+// A non-positive value keeps the configured default.
+func WithMaxTokens(maxTokens int) CompleteOption {
+	return func(p *completeParams) {
+		if maxTokens > 0 {
+			p.maxTokens = maxTokens
+		}
+	}
+}
+
+// WithModel sets the model for a single request. An empty value keeps the configured default.
+func WithModel(model string) CompleteOption {
+	return func(p *completeParams) {
+		if model != "" {
+			p.model = model
+		}
+	}
+}
+
+// rankingOpts returns the request options used for topic ranking
+func (c *Client) rankingOpts() []CompleteOption {
+	return taskOpts(c.rankingModel, c.rankingTemp)
+}
+
+// MaxRankBatchSize caps the topics per ranking request; the response for larger batches
+// doesn't fit in a typical max_tokens budget
+const MaxRankBatchSize = 50
+
+// RankBatchSize returns how many topics to pass to each RankTopics call, clamped to 1..MaxRankBatchSize
+func (c *Client) RankBatchSize() int {
+	switch {
+	case c.rankBatch < 1:
+		return 1
+	case c.rankBatch > MaxRankBatchSize:
+		return MaxRankBatchSize
 	}
+	return c.rankBatch
+}
+
+// contentOpts returns the request options used for content generation
+func (c *Client) contentOpts() []CompleteOption {
+	return taskOpts(c.contentModel, c.contentTemp)
 }
 
 // Complete sends a message to Claude and returns the response
-func (c *Client) Complete(ctx context.Context, systemPrompt, userMessage string) (string, error) {
+func (c *Client) Complete(ctx context.Context, systemPrompt, userMessage string, opts ...CompleteOption) (string, error) {
+	if err := c.checkBudget(ctx); err != nil {
+		return "", err
+	}
+
 	// Wait for rate limiter
 	if err := c.rateLimiter.Wait(ctx, ratelimit.LimiterAnthropic); err != nil {
 		return "", fmt.Errorf("rate limit error: %w", err)
 	}
 
+	params := completeParams{model: c.model, temperature: c.temperature, maxTokens: c.maxTokens}
+	for _, opt := range opts {
+		opt(&params)
+	}
+
 	c.log.Debug().
-		Str("model", c.model).
-		Int("max_tokens", c.maxTokens).
+		Str("model", params.model).
+		Int("max_tokens", params.maxTokens).
+		Float64("temperature", params.temperature).
 		Msg("Sending request to Claude")
 
 	message, err := c.client.Messages.New(ctx, anthropic.MessageNewParams{
-		Model:     anthropic.Model(c.model),
-		MaxTokens: int64(c.maxTokens),
+		Model:       anthropic.Model(params.model),
+		MaxTokens:   int64(params.maxTokens),
+		Temperature: anthropic.Float(params.temperature),
 		System: []anthropic.TextBlockParam{
 			{
 				Type: "text",
@@ -71,7 +261,7 @@ func (c *Client) Complete(ctx context.Context, systemPrompt, userMessage string)
 
 	if err != nil {
 		c.log.Error().Err(err).Msg("Claude API error")
-		return "", fmt.Errorf("claude API error: %w", err)
+		return "", apiError(err)
 	}
 
 	// Extract text from response
@@ -83,18 +273,157 @@ func (c *Client) Complete(ctx context.Context, systemPrompt, userMessage string)
 		}
 	}
 
+	c.recordUsage(ctx, message.Usage.InputTokens, message.Usage.OutputTokens)
+
 	c.log.Debug().
 		Int("input_tokens", int(message.Usage.InputTokens)).
 		Int("output_tokens", int(message.Usage.OutputTokens)).
 		Msg("Received Claude response")
 
+	// A response cut off mid-JSON can't be parsed; report it instead of letting callers
+	// fail on a confusing unmarshal error
+	if message.StopReason == anthropic.StopReasonMaxTokens {
+		c.log.Warn().
+			Int("max_tokens", params.maxTokens).
+			Msg("Claude response hit max_tokens")
+		return "", fmt.Errorf("%w (%d tokens)", ErrResponseTruncated, params.maxTokens)
+	}
+
 	return response, nil
 }
 
-// CompleteWithJSON sends a message and expects a JSON response
-func (c *Client) CompleteWithJSON(ctx context.Context, systemPrompt, userMessage string) (string, error) {
+// recordUsage adds a response's token counts to the running and daily totals, and to the
+// usage store if there is one
+func (c *Client) recordUsage(ctx context.Context, inputTokens, outputTokens int64) {
+	c.usageMu.Lock()
+	c.resetDailyUsage()
+	day := c.usageDay
+	for _, u := range []*Usage{&c.usageTotal, &c.usageToday} {
+		u.Requests++
+		u.InputTokens += inputTokens
+		u.OutputTokens += outputTokens
+	}
+	c.usageMu.Unlock()
+
+	if c.usageStore == nil {
+		return
+	}
+	usage := &models.AIUsage{Day: day, Requests: 1, InputTokens: inputTokens, OutputTokens: outputTokens}
+	if err := c.usageStore.AddAIUsage(ctx, usage); err != nil {
+		c.log.Warn().Err(err).Msg("Failed to record Claude usage")
+	}
+}
+
+// resetDailyUsage clears the daily counters when the day has changed. Callers hold usageMu.
+func (c *Client) resetDailyUsage() {
+	today := time.Now().Format("2006-01-02")
+	if c.usageDay != today {
+		c.usageDay = today
+		c.usageToday = Usage{}
+	}
+}
+
+// checkBudget returns ErrBudgetExceeded when today's usage has reached a configured cap.
+// With a usage store, today's usage is the stored total; if it can't be read, this
+// process's own count is used.
+func (c *Client) checkBudget(ctx context.Context) error {
+	if c.maxRequestsPerDay <= 0 && c.maxTokensPerDay <= 0 {
+		return nil
+	}
+
+	today := c.UsageToday()
+	if c.usageStore != nil {
+		stored, err := c.usageStore.GetAIUsage(ctx, time.Now().Format("2006-01-02"))
+		if err != nil {
+			c.log.Warn().Err(err).Msg("Failed to read Claude usage, checking the budget against this process only")
+		} else {
+			today = Usage{Requests: stored.Requests, InputTokens: stored.InputTokens, OutputTokens: stored.OutputTokens}
+		}
+	}
+
+	if c.maxRequestsPerDay > 0 && today.Requests >= c.maxRequestsPerDay {
+		return fmt.Errorf("%w: %d of %d requests used today", ErrBudgetExceeded, today.Requests, c.maxRequestsPerDay)
+	}
+	if tokens := today.InputTokens + today.OutputTokens; c.maxTokensPerDay > 0 && tokens >= c.maxTokensPerDay {
+		return fmt.Errorf("%w: %d of %d tokens used today", ErrBudgetExceeded, tokens, c.maxTokensPerDay)
+	}
+	return nil
+}
+
+// Usage returns the requests and tokens used since the client was created
+func (c *Client) Usage() Usage {
+	c.usageMu.Lock()
+	defer c.usageMu.Unlock()
+	return c.usageTotal
+}
+
+// UsageToday returns the requests and tokens used so far today
+func (c *Client) UsageToday() Usage {
+	c.usageMu.Lock()
+	defer c.usageMu.Unlock()
+	c.resetDailyUsage()
+	return c.usageToday
+}
+
+// CompleteWithJSON sends a message and expects a JSON response. A response truncated at
+// max_tokens is retried once with double the limit (up to maxTruncationRetryTokens).
+func (c *Client) CompleteWithJSON(ctx context.Context, systemPrompt, userMessage string, opts ...CompleteOption) (string, error) {
 	// Add JSON instruction to system prompt
 	enhancedSystem := systemPrompt + "\n\nIMPORTANT: Respond ONLY with valid JSON. No markdown, no explanation, just the JSON object."
 
-	return c.Complete(ctx, enhancedSystem, userMessage)
+	response, err := c.Complete(ctx, enhancedSystem, userMessage, opts...)
+	if !errors.Is(err, ErrResponseTruncated) {
+		return response, err
+	}
+
+	params := completeParams{maxTokens: c.maxTokens}
+	for _, opt := range opts {
+		opt(&params)
+	}
+	if params.maxTokens >= maxTruncationRetryTokens {
+		return "", err
+	}
+	retryTokens := min(params.maxTokens*2, maxTruncationRetryTokens)
+
+	c.log.Warn().
+		Int("max_tokens", retryTokens).
+		Msg("JSON response was truncated, retrying with a higher token limit")
+
+	return c.Complete(ctx, enhancedSystem, userMessage, append(opts, WithMaxTokens(retryTokens))...)
+}
+
+// invalidJSONReminder is appended to the user prompt when re-asking after an unparseable response
+const invalidJSONReminder = "\n\nYour previous response was not valid JSON. Respond with ONLY the valid JSON object - no prose, no markdown."
+
+// parseJSON unmarshals a JSON response into v. If that fails and retrying is enabled, the
+// request is sent once more with a reminder to return only JSON. It returns the response
+// that was finally parsed (or the last one received).
+func (c *Client) parseJSON(ctx context.Context, systemPrompt, userPrompt, response string, v interface{}, opts ...CompleteOption) (string, error) {
+	err := json.Unmarshal([]byte(stripMarkdownCodeBlock(response)), v)
+	if err == nil || !c.retryJSON {
+		return response, err
+	}
+
+	c.log.Warn().
+		Err(err).
+		Msg("Response was not valid JSON, retrying once")
+
+	retry, retryErr := c.CompleteWithJSON(ctx, systemPrompt, userPrompt+invalidJSONReminder, opts...)
+	if retryErr != nil {
+		return response, err
+	}
+
+	return retry, json.Unmarshal([]byte(stripMarkdownCodeBlock(retry)), v)
+}
+
+// rawResponse returns the raw exchange when saving raw responses is enabled, nil otherwise
+func (c *Client) rawResponse(systemPrompt, userPrompt, response string) *RawResponse {
+	if !c.saveRaw {
+		return nil
+	}
+	return &RawResponse{
+		SystemPrompt: systemPrompt,
+		UserPrompt:   userPrompt,
+		Response:     response,
+	}
 }
@@ -0,0 +1,120 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/linkedin-agent/internal/config"
+	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/pkg/logger"
+	"github.com/linkedin-agent/pkg/ratelimit"
+)
+
+// memoryUsageStore is a UsageStore shared by the clients of a test, standing in for the repository
+type memoryUsageStore struct {
+	mu   sync.Mutex
+	days map[string]models.AIUsage
+}
+
+func (s *memoryUsageStore) AddAIUsage(ctx context.Context, usage *models.AIUsage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	total := s.days[usage.Day]
+	total.Day = usage.Day
+	total.Requests += usage.Requests
+	total.InputTokens += usage.InputTokens
+	total.OutputTokens += usage.OutputTokens
+	s.days[usage.Day] = total
+	return nil
+}
+
+func (s *memoryUsageStore) GetAIUsage(ctx context.Context, day string) (*models.AIUsage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	usage := s.days[day]
+	usage.Day = day
+	return &usage, nil
+}
+
+// newFakeAnthropic returns a server answering every message with "OK", counting the calls
+func newFakeAnthropic(t *testing.T, calls *int) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		*calls++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":          "msg_1",
+			"type":        "message",
+			"role":        "assistant",
+			"model":       "claude-test",
+			"content":     []map[string]string{{"type": "text", "text": "OK"}},
+			"stop_reason": "end_turn",
+			"usage":       map[string]int{"input_tokens": 10, "output_tokens": 5},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestDailyBudgetSharedThroughUsageStore(t *testing.T) {
+	ctx := context.Background()
+	var calls int
+	server := newFakeAnthropic(t, &calls)
+
+	cfg := config.AnthropicConfig{APIKey: "test-key", BaseURL: server.URL, Model: "claude-test", MaxTokens: 100, MaxRequestsPerDay: 2}
+	limiter := ratelimit.NewMultiLimiter()
+	limiter.AddLimiter(ratelimit.LimiterAnthropic, 1000, 100)
+	log := logger.New(logger.Config{Level: "error"})
+	store := &memoryUsageStore{days: make(map[string]models.AIUsage)}
+
+	first := NewClient(cfg, limiter, log)
+	first.SetUsageStore(store)
+	for i := 0; i < 2; i++ {
+		if _, err := first.Complete(ctx, "system", "ping"); err != nil {
+			t.Fatalf("Complete %d: %v", i+1, err)
+		}
+	}
+
+	// A new client, as after a restart or in another process, sees the requests already made today
+	restarted := NewClient(cfg, limiter, log)
+	restarted.SetUsageStore(store)
+	if _, err := restarted.Complete(ctx, "system", "ping"); !errors.Is(err, ErrBudgetExceeded) {
+		t.Errorf("Complete after restart: got %v, want ErrBudgetExceeded", err)
+	}
+	if calls != 2 {
+		t.Errorf("Claude was called %d times, want the daily cap of 2", calls)
+	}
+}
+
+func TestRankingTemperatureZeroIsHonored(t *testing.T) {
+	zero := 0.0
+	client := NewClient(config.AnthropicConfig{Temperature: 0.7, RankingTemperature: &zero}, ratelimit.NewMultiLimiter(), logger.New(logger.Config{Level: "error"}))
+
+	params := completeParams{temperature: client.temperature}
+	for _, opt := range client.rankingOpts() {
+		opt(&params)
+	}
+	if params.temperature != 0 {
+		t.Errorf("ranking temperature = %v, want 0", params.temperature)
+	}
+
+	params = completeParams{temperature: client.temperature}
+	for _, opt := range client.contentOpts() {
+		opt(&params)
+	}
+	if params.temperature != 0.7 {
+		t.Errorf("content temperature without an override = %v, want the default 0.7", params.temperature)
+	}
+}
@@ -61,13 +61,9 @@ Your writing style:
 ╔═══════════════════════════════════════════════════════════════════════════════╗
 ║ ⚠️  CRITICAL REQUIREMENTS - VIOLATION WILL CAUSE REJECTION ⚠️                   ║
 ╠═══════════════════════════════════════════════════════════════════════════════╣
-║ 1. FIRST LINE MUST BE: "Tech Insights from Ros - [Today's Date]"              ║
-║    Example: "Tech Insights from Ros - Feb 4, 2026"                            ║
+║ 1. FIRST LINE MUST BE THE HEADER BELOW, WITH TODAY'S DATE                     ║
 ║                                                                               ║
-║ 2. LAST LINES MUST BE THE FOOTER (after hashtags):                            ║
-║    ---                                                                        ║
-║    LinkedIn: https://www.linkedin.com/in/qa-lead-rostyslav-chabria/           ║
-║    Instagram: https://www.instagram.com/rostislav_cha                         ║
+║ 2. LAST LINES MUST BE THE FOOTER BELOW (after hashtags)                       ║
 ║                                                                               ║
 ║ 3. NEVER FABRICATE PERSONAL EXPERIENCE:                                       ║
 ║    ❌ WRONG: "I tested this", "I watched", "Our team found"                   ║
@@ -76,6 +72,12 @@ Your writing style:
 ║ 4. NO EMOJIS ANYWHERE IN THE POST                                             ║
 ╚═══════════════════════════════════════════════════════════════════════════════╝
 
+HEADER: "{{author_header}} - [Today's Date]"
+Example: "{{author_header}} - Feb 4, 2026"
+
+FOOTER:
+{{author_footer}}
+
 ═══════════════════════════════════════════════════════════════════════════════
 RESEARCH-BACKED RULES FOR VIRAL LINKEDIN POSTS
 (Based on analysis of 34,000+ viral posts and top tech creators)
@@ -204,7 +206,7 @@ STRUCTURE TOOLS:
 • "Wave rhythm": vary sentence length (short, longer, short)
 
 LENGTH:
-• Target: 250-300 words
+• Target: about %d words
 • Every sentence must drive story forward or deliver a point
 • If it doesn't add value, CUT IT
 • "End the post where the reader naturally wants to respond"
@@ -247,13 +249,8 @@ PROVEN CTAs:
 
 RULE: Ask ONE clear question, not multiple. Make it easy to answer.
 
-3. FOOTER WITH AUTHOR LINKS (MANDATORY - NEVER SKIP):
-   ALWAYS include this footer AFTER hashtags. This is NOT optional.
-
-   Format:
-   ---
-   LinkedIn: https://www.linkedin.com/in/qa-lead-rostyslav-chabria/
-   Instagram: https://www.instagram.com/rostislav_cha
+3. FOOTER (MANDATORY - NEVER SKIP):
+   ALWAYS end the post with the FOOTER given at the top, exactly as written, AFTER hashtags.
 
 === RULE 8: CONTENT THEMES THAT RESONATE IN TECH ===
 
@@ -281,7 +278,7 @@ DATA: Positive/celebratory posts get ~60%% more shares than average.
 
 === FINAL CHECKLIST (MUST PASS ALL) ===
 
-□ HEADER: Starts with "Tech Insights from Ros - [Month Day, Year]"
+□ HEADER: Starts with "{{author_header}} - [Month Day, Year]"
 □ Hook grabs attention in first 210 characters (after header)
 □ Triggers at least one strong emotion (inspiration/curiosity/fear/validation)
 □ NO FABRICATED EXPERIENCES - use third-person for things you didn't personally do
@@ -289,10 +286,10 @@ DATA: Positive/celebratory posts get ~60%% more shares than average.
 □ Analyzes news/trends from industry observer perspective (not fake participant)
 □ Provides actionable value or quotable insight
 □ Ends with memorable takeaway + ONE engagement question
-□ Under 3000 characters total (LinkedIn limit)
+□ Under %d characters total, including header, hashtags and footer
 □ NO EMOJIS - use unicode separators or plain text formatting only
 □ 3-5 relevant hashtags at the end
-□ FOOTER (MANDATORY): Separator line + LinkedIn and Instagram links after hashtags
+□ FOOTER (MANDATORY): The FOOTER given at the top, after hashtags
 □ No jargon walls - accessible to broad professional audience
 □ Has "social currency" - readers would look smart sharing it`
 
@@ -301,24 +298,52 @@ Angle: %s
 Details: %s
 
 Write a LinkedIn post. The content field in your JSON response must start with this exact line:
-Tech Insights from Ros - Feb 4, 2026
+{{author_header}} - Feb 4, 2026
 
-And must end with these exact lines after the hashtags:
----
-LinkedIn: https://www.linkedin.com/in/qa-lead-rostyslav-chabria/
-Instagram: https://www.instagram.com/rostislav_cha
+And must end with this footer after the hashtags:
+{{author_footer}}
 
 Write from a third-person industry observer perspective. Use phrases like "Developers report that..." or "Teams are finding..." instead of "I tested" or "I found".
 
 Do not use any emojis.
 
 {
-  "content": "Tech Insights from Ros - Feb 4, 2026\n\n[hook]\n\n[body using third-person perspective]\n\n[insights]\n\n[question for engagement]\n\n#tag1 #tag2 #tag3\n\n---\nLinkedIn: https://www.linkedin.com/in/qa-lead-rostyslav-chabria/\nInstagram: https://www.instagram.com/rostislav_cha",
+  "content": "{{author_header}} - Feb 4, 2026\n\n[hook]\n\n[body using third-person perspective]\n\n[insights]\n\n[question for engagement]\n\n#tag1 #tag2 #tag3{{author_footer_json}}",
   "hashtags": ["tag1", "tag2"],
   "hook": "the hook line",
   "cta": "the question"
 }`
 
+	// ContentTemplateInstruction is appended to the content prompt when a post template is used
+	ContentTemplateInstruction = `
+
+Structure the post body (between the header line and the hashtags) EXACTLY like this template.
+Keep its layout, line breaks and fixed wording, and replace every {placeholder} with your own content
+({hook} = the hook line, {cta} = the engagement question, other placeholders as their names suggest).
+Do not leave any {placeholder} in the output.
+
+TEMPLATE:
+%s`
+
+	// AvoidOpenersInstruction is appended to the content prompt with recently used hook openers
+	AvoidOpenersInstruction = `
+
+Recent posts already opened with the lines below. Use a DIFFERENT hook formula and do not start
+the hook with any of these openers:
+%s`
+
+	// RevisionInstruction is appended to the content prompt when regenerating a draft with feedback
+	RevisionInstruction = `
+
+You already wrote the draft below for this topic. Rewrite it following the reviewer's feedback,
+keeping everything the feedback does not ask to change.
+
+PREVIOUS DRAFT:
+%s
+
+FEEDBACK:
+%s`
+
 	// Poll generation
 	PollGenerationUserPrompt = `Create a LinkedIn poll about the following topic.
 
@@ -328,10 +353,12 @@ Context: %s
 Respond in JSON format:
 {
   "question": "<poll question, max 140 chars>",
-  "options": ["<option1>", "<option2>", "<option3>", "<option4>"],
+  "options": ["<option1, max 30 chars>", "<option2>", "<option3>", "<option4>"],
   "intro_text": "<brief text to introduce the poll>",
   "hashtags": ["<hashtags>"]
-}`
+}
+
+Provide 2 to 4 options, each at most 30 characters.`
 )
 
 // Daily digest prompt (for top 3 news)
@@ -369,8 +396,8 @@ DIGEST HOOK FORMULAS:
 === DIGEST STRUCTURE ===
 
 1. HEADER - Time-appropriate title with date:
-   - Morning posts: "Morning Updates from Ros - [Month Day, Year]" (e.g., "Morning Updates from Ros - Feb 5, 2026")
-   - Evening posts: "Nightly Updates from Ros - [Month Day, Year]" (e.g., "Nightly Updates from Ros - Feb 5, 2026")
+   - Morning posts: "{{author_morning_header}} - [Month Day, Year]" (e.g., "{{author_morning_header}} - Feb 5, 2026")
+   - Evening posts: "{{author_nightly_header}} - [Month Day, Year]" (e.g., "{{author_nightly_header}} - Feb 5, 2026")
 2. HOOK (first 210 chars) - Stop the scroll with biggest story or compelling summary
 3. BRIEF INTRO - One sentence setting up today's digest
 4. NEWS #1: [1] [Headline] - 2-3 sentences + WHY IT MATTERS to the reader
@@ -378,9 +405,8 @@ DIGEST HOOK FORMULAS:
 6. NEWS #3: [3] [Headline] - 2-3 sentences + WHY IT MATTERS to the reader
 7. TAKEAWAY - One sentence on the bigger picture or connecting thread
 8. CTA - ONE clear question to spark discussion
-9. FOOTER - Links to author profiles:
-   LinkedIn: https://www.linkedin.com/in/qa-lead-rostyslav-chabria/
-   Instagram: https://www.instagram.com/rostislav_cha
+9. FOOTER - After the hashtags, exactly as written:
+{{author_footer}}
 
 === FORMATTING RULES (Mobile-First) ===
 
@@ -391,7 +417,7 @@ DIGEST HOOK FORMULAS:
 • Number news items as [1], [2], [3] - NO EMOJIS
 • Source attribution adds credibility: "(via TechCrunch)"
 • End with 3-5 relevant hashtags
-• Footer with author links on separate lines
+• Footer exactly as given in the structure above
 
 === TONE & LANGUAGE ===
 
@@ -423,7 +449,7 @@ CTA EXAMPLES:
 
 === FINAL CHECKLIST ===
 
-□ Header: "Morning Updates from Ros - [date]" or "Nightly Updates from Ros - [date]"
+□ Header: "{{author_morning_header}} - [date]" or "{{author_nightly_header}} - [date]"
 □ Hook grabs attention in first 210 characters
 □ Each news item explains WHY IT MATTERS (not just what happened)
 □ Short paragraphs with white space between each section
@@ -432,7 +458,7 @@ CTA EXAMPLES:
 □ Ends with ONE engagement question
 □ Under 2500 characters total
 □ 3-5 relevant hashtags
-□ Footer with LinkedIn and Instagram links
+□ Footer exactly as given in the structure above
 □ Accessible to non-specialists`
 
 	DigestGenerationUserPrompt = `Create a daily tech news digest LinkedIn post featuring these TOP 3 stories:
@@ -461,6 +487,85 @@ Respond in JSON format:
 }`
 )
 
+// Weekly recap prompt (for the week's published posts)
+const (
+	WeeklyRecapSystemPrompt = `You are an expert LinkedIn content creator writing a weekly "week in tech" recap.
+
+Your writing style:
+%s
+
+=== PURPOSE ===
+
+The recap looks back at stories the author already covered this week. Readers may have
+missed some of them, so each item should stand on its own, and the recap should surface
+the theme that connects the week.
+
+=== RECAP STRUCTURE ===
+
+1. HEADER: "{{author_recap_header}} - [Month Day, Year]"
+2. HOOK (first 210 characters) - The single biggest theme or story of the week
+3. STORIES: Number each as [1], [2], [3]... - one short headline plus 1-2 sentences on why it mattered
+4. PATTERN - 1-2 sentences connecting the stories: what did this week tell us?
+5. LOOK AHEAD - One sentence on what to watch next week
+6. CTA - ONE clear question to spark discussion
+7. FOOTER - After the hashtags, exactly as written:
+{{author_footer}}
+
+=== FORMATTING RULES ===
+
+• Keep total post under 2000 characters
+• SHORT PARAGRAPHS: 1-2 sentences MAX, blank line between sections
+• NO EMOJIS - use [1], [2], [3] numbering and simple --- separators
+• Conversational, opinionated, accessible to non-specialists
+• Do NOT invent stories that are not in the list
+• End with 3-5 relevant hashtags`
+
+	WeeklyRecapUserPrompt = `Create a weekly tech recap LinkedIn post from the stories covered this week, most important first:
+
+%s
+Respond in JSON format:
+{
+  "content": "<the full LinkedIn recap post>",
+  "hashtags": ["<hashtag1>", "<hashtag2>", "<hashtag3>"],
+  "hook": "<the opening line>",
+  "cta": "<the call-to-action question>"
+}`
+)
+
+// Long-form article prompt (for a cluster of related topics)
+const (
+	ArticleGenerationSystemPrompt = `You are an expert technology writer producing long-form LinkedIn articles.
+
+Your writing style:
+%s
+
+=== ARTICLE RULES ===
+
+• Length: 900-1500 words across 4-6 sections
+• TITLE: Specific and benefit-driven, under 100 characters. No clickbait.
+• SUBTITLE: One sentence on what the reader will learn
+• SECTIONS: Each has a short heading and 2-5 paragraphs of body text
+• Open with why this matters now; close with practical takeaways and an open question
+• Connect the stories into one narrative - do not just summarize them one by one
+• Attribute facts to their sources ("according to TechCrunch"); do not invent statistics
+• Plain text only inside sections: no markdown headings, no emojis
+• TEASER: A 2-4 sentence feed post (under 600 characters) that makes people open the article`
+
+	ArticleGenerationUserPrompt = `Write a long-form LinkedIn article that ties together these related stories:
+
+%s
+Respond in JSON format:
+{
+  "title": "<article title>",
+  "subtitle": "<one-sentence subtitle>",
+  "sections": [
+    {"heading": "<section heading>", "body": "<section paragraphs separated by blank lines>"}
+  ],
+  "teaser": "<short feed post promoting the article>",
+  "hashtags": ["<hashtag1>", "<hashtag2>", "<hashtag3>"]
+}`
+)
+
 // Image search keyword generation prompt
 const (
 	ImageSearchSystemPrompt = `You are an expert at generating image search keywords for stock photography on Unsplash.
@@ -646,6 +751,45 @@ Respond in JSON format:
   "comment": "<the comment text, 1-3 sentences>",
   "reasoning": "<brief explanation of why this comment adds value>"
 }`
+
+	// CommentQuoteInstruction is appended to the comment prompt to anchor the comment in the post
+	CommentQuoteInstruction = `
+
+Anchor the comment in ONE specific point from the post: quote or closely paraphrase a sentence from it.
+Also return that exact text from the post, copied character-for-character, in a "quote" field:
+{
+  "comment": "<the comment text, 1-3 sentences>",
+  "reasoning": "<brief explanation of why this comment adds value>",
+  "quote": "<exact sentence or phrase copied from the post>"
+}`
+
+	CommentReviewSystemPrompt = `You are a strict editor reviewing LinkedIn comments before they are posted.
+Your task is to judge whether a draft comment is relevant to the post and sounds authentic.
+
+SCORING (0-100):
+- 80-100: Specific to the post, adds real value, sounds like a real person
+- 60-79: Relevant but somewhat generic or bland
+- 0-59: Generic ("Great post!"), off-topic, promotional, or obviously AI-written
+
+If the score is below 70, rewrite the comment so it references a specific point from the post,
+follows the same rules as the original (1-3 sentences, NO emojis, NO hashtags, not promotional).
+If the comment is already good, leave "revised_comment" empty.`
+
+	CommentReviewUserPrompt = `Review this draft comment.
+
+Author: %s
+Post content:
+%s
+
+Draft comment:
+%s
+
+Respond in JSON format:
+{
+  "score": <0-100>,
+  "feedback": "<brief explanation of the score>",
+  "revised_comment": "<improved comment, or empty if the draft is good>"
+}`
 )
 
 // Topic expansion prompt (for custom keywords)
@@ -3,10 +3,15 @@ package ai
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/linkedin-agent/internal/config"
 	"github.com/linkedin-agent/internal/models"
 )
 
@@ -58,13 +63,13 @@ func (c *Client) RankTopic(ctx context.Context, topic *models.RawTopic) (*TopicR
 		topic.URL,
 	)
 
-	response, err := c.CompleteWithJSON(ctx, TopicRankingSystemPrompt, userPrompt)
+	response, err := c.CompleteWithJSON(ctx, TopicRankingSystemPrompt, userPrompt, c.rankingOpts()...)
 	if err != nil {
 		return nil, err
 	}
 
 	var ranking TopicRanking
-	if err := json.Unmarshal([]byte(stripMarkdownCodeBlock(response)), &ranking); err != nil {
+	if response, err = c.parseJSON(ctx, TopicRankingSystemPrompt, userPrompt, response, &ranking, c.rankingOpts()...); err != nil {
 		c.log.Error().
 			Err(err).
 			Str("response", response).
@@ -75,7 +80,33 @@ func (c *Client) RankTopic(ctx context.Context, topic *models.RawTopic) (*TopicR
 	return &ranking, nil
 }
 
-// RankTopics analyzes multiple topics in batch (more efficient)
+// rankEach ranks topics one request at a time. Rankings line up with topics; a topic that
+// fails to rank is left nil. It stops early when the daily budget runs out, Claude is rate
+// limited or overloaded, or ctx is done.
+func (c *Client) rankEach(ctx context.Context, topics []*models.RawTopic) ([]*TopicRanking, error) {
+	rankings := make([]*TopicRanking, len(topics))
+	for i, topic := range topics {
+		ranking, err := c.RankTopic(ctx, topic)
+		if errors.Is(err, ErrBudgetExceeded) || IsTransient(err) {
+			return nil, err
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if err != nil {
+			c.log.Warn().
+				Err(err).
+				Str("title", topic.Title).
+				Msg("Failed to rank topic, skipping")
+			continue
+		}
+		rankings[i] = ranking
+	}
+	return rankings, nil
+}
+
+// RankTopics analyzes multiple topics in batch (more efficient). If the batch response is
+// truncated or can't be parsed, the topics are ranked individually instead.
 func (c *Client) RankTopics(ctx context.Context, topics []*models.RawTopic) ([]*TopicRanking, error) {
 	if len(topics) == 0 {
 		return nil, nil
@@ -83,19 +114,7 @@ func (c *Client) RankTopics(ctx context.Context, topics []*models.RawTopic) ([]*
 
 	// For small batches, rank individually
 	if len(topics) <= 3 {
-		rankings := make([]*TopicRanking, 0, len(topics))
-		for _, topic := range topics {
-			ranking, err := c.RankTopic(ctx, topic)
-			if err != nil {
-				c.log.Warn().
-					Err(err).
-					Str("title", topic.Title).
-					Msg("Failed to rank topic, skipping")
-				continue
-			}
-			rankings = append(rankings, ranking)
-		}
-		return rankings, nil
+		return c.rankEach(ctx, topics)
 	}
 
 	// Build topics list for batch prompt
@@ -107,18 +126,26 @@ func (c *Client) RankTopics(ctx context.Context, topics []*models.RawTopic) ([]*
 
 	userPrompt := fmt.Sprintf(BatchTopicRankingUserPrompt, topicsText)
 
-	response, err := c.CompleteWithJSON(ctx, TopicRankingSystemPrompt, userPrompt)
+	response, err := c.CompleteWithJSON(ctx, TopicRankingSystemPrompt, userPrompt, c.rankingOpts()...)
+	if errors.Is(err, ErrResponseTruncated) {
+		// Even the larger token limit wasn't enough for the whole batch
+		c.log.Warn().
+			Err(err).
+			Int("topics", len(topics)).
+			Msg("Batch ranking response truncated, ranking topics individually")
+		return c.rankEach(ctx, topics)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	var batchResponse BatchRankingResponse
-	if err := json.Unmarshal([]byte(stripMarkdownCodeBlock(response)), &batchResponse); err != nil {
+	if response, err = c.parseJSON(ctx, TopicRankingSystemPrompt, userPrompt, response, &batchResponse, c.rankingOpts()...); err != nil {
 		c.log.Error().
 			Err(err).
 			Str("response", response).
-			Msg("Failed to parse batch ranking response")
-		return nil, fmt.Errorf("failed to parse batch ranking response: %w", err)
+			Msg("Failed to parse batch ranking response, ranking topics individually")
+		return c.rankEach(ctx, topics)
 	}
 
 	// Convert to TopicRanking slice
@@ -139,41 +166,406 @@ func (c *Client) RankTopics(ctx context.Context, topics []*models.RawTopic) ([]*
 
 // GeneratedContent represents AI-generated LinkedIn content
 type GeneratedContent struct {
-	Content  string   `json:"content"`
-	Hashtags []string `json:"hashtags"`
-	Hook     string   `json:"hook"`
-	CTA      string   `json:"cta"`
+	Content  string       `json:"content"`
+	Hashtags []string     `json:"hashtags"`
+	Hook     string       `json:"hook"`
+	CTA      string       `json:"cta"`
+	Raw      *RawResponse `json:"-"`
+}
+
+// Identity used when publishing.author is not configured
+const (
+	defaultAuthorName         = "Ros"
+	defaultAuthorLinkedInURL  = "https://www.linkedin.com/in/qa-lead-rostyslav-chabria/"
+	defaultAuthorInstagramURL = "https://www.instagram.com/rostislav_cha"
+)
+
+// authorIdentity is the resolved header and footer text for an author
+type authorIdentity struct {
+	name   string // Display name, may be empty
+	header string // Single-topic post header without the date
+	footer string // Footer block, empty when disabled
+	marker string // Substring that identifies the footer in generated content
+}
+
+// newAuthorIdentity resolves the configured author, falling back to the original identity when unset
+func newAuthorIdentity(cfg config.AuthorConfig) authorIdentity {
+	if cfg.IsEmpty() {
+		cfg.DisplayName = defaultAuthorName
+		cfg.LinkedInURL = defaultAuthorLinkedInURL
+		cfg.InstagramURL = defaultAuthorInstagramURL
+	}
+
+	a := authorIdentity{name: cfg.DisplayName}
+
+	if cfg.HeaderTemplate != "" {
+		a.header = strings.TrimSpace(strings.ReplaceAll(cfg.HeaderTemplate, "{name}", cfg.DisplayName))
+	} else {
+		a.header = a.titled("Tech Insights")
+	}
+
+	if cfg.EnableFooter {
+		var links []string
+		if cfg.LinkedInURL != "" {
+			links = append(links, "LinkedIn: "+cfg.LinkedInURL)
+		}
+		if cfg.InstagramURL != "" {
+			links = append(links, "Instagram: "+cfg.InstagramURL)
+		}
+		if len(links) > 0 {
+			a.footer = "---\n" + strings.Join(links, "\n")
+			a.marker = footerMarker(cfg.LinkedInURL)
+			if a.marker == "" {
+				a.marker = footerMarker(cfg.InstagramURL)
+			}
+		}
+	}
+
+	return a
+}
+
+// footerMarker strips the scheme, "www." and trailing slash from a profile URL
+func footerMarker(profileURL string) string {
+	marker := strings.TrimPrefix(strings.TrimPrefix(profileURL, "https://"), "http://")
+	return strings.TrimSuffix(strings.TrimPrefix(marker, "www."), "/")
+}
+
+// titled appends "from <name>" to a header prefix when a display name is set
+func (a authorIdentity) titled(prefix string) string {
+	if a.name == "" {
+		return prefix
+	}
+	return prefix + " from " + a.name
+}
+
+// hasFooter reports whether the content already ends with the author footer (always true when disabled)
+func (a authorIdentity) hasFooter(content string) bool {
+	return a.footer == "" || strings.Contains(content, a.marker)
+}
+
+// appendFooter adds the footer after the content unless it is already present
+func (a authorIdentity) appendFooter(content string) string {
+	if a.hasFooter(content) {
+		return content
+	}
+	return strings.TrimSpace(content) + "\n\n" + a.footer
+}
+
+// applyToPrompt fills the author placeholders of a prompt
+func (a authorIdentity) applyToPrompt(prompt string) string {
+	footer := a.footer
+	footerJSON := `\n\n` + strings.ReplaceAll(a.footer, "\n", `\n`)
+	if footer == "" {
+		footer = "(none - do not add a footer, end the post with the hashtags)"
+		footerJSON = ""
+	}
+
+	return strings.NewReplacer(
+		"{{author_header}}", a.header,
+		"{{author_name}}", a.name,
+		"{{author_morning_header}}", a.titled("Morning Updates"),
+		"{{author_nightly_header}}", a.titled("Nightly Updates"),
+		"{{author_recap_header}}", a.titled("Week in Tech"),
+		"{{author_footer}}", footer,
+		"{{author_footer_json}}", footerJSON,
+	).Replace(prompt)
+}
+
+// replaceHeader swaps a first line starting with any of the prefixes for header, or prepends header
+func replaceHeader(content, header string, prefixes ...string) string {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(content, prefix) {
+			if idx := strings.Index(content, "\n"); idx != -1 {
+				return header + content[idx:]
+			}
+			return content
+		}
+	}
+	return header + "\n\n" + content
 }
 
 // postProcessContent ensures header and footer are present in the content
-func postProcessContent(content string) string {
+func postProcessContent(content string, author authorIdentity) string {
 	// Generate header with today's date
 	today := time.Now().Format("Jan 2, 2006")
-	header := "Tech Insights from Ros | " + today
-	footer := "---\nLinkedIn: https://www.linkedin.com/in/qa-lead-rostyslav-chabria/\nInstagram: https://www.instagram.com/rostislav_cha"
+	header := author.header + " | " + today
+
+	// Drop any header the model emitted below the first line, so it isn't duplicated
+	content = removeMisplacedHeaders(content, author.header)
 
 	// Always ensure correct header with today's date
-	if strings.HasPrefix(content, "Tech Insights from Ros") {
-		// Replace the first line (header) with correct date
-		if idx := strings.Index(content, "\n"); idx != -1 {
-			content = header + content[idx:]
+	content = replaceHeader(content, header, author.header)
+
+	return author.appendFooter(content)
+}
+
+// removeMisplacedHeaders removes header lines that appear anywhere other than the first line
+func removeMisplacedHeaders(content, headerPrefix string) string {
+	lines := strings.Split(content, "\n")
+	kept := make([]string, 0, len(lines))
+	skipped := false
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if i > 0 && strings.HasPrefix(trimmed, headerPrefix) {
+			skipped = true
+			continue
 		}
-	} else {
-		content = header + "\n\n" + content
+		// Collapse the blank lines left around a removed header
+		if skipped && trimmed == "" && len(kept) > 0 && strings.TrimSpace(kept[len(kept)-1]) == "" {
+			continue
+		}
+		skipped = false
+		kept = append(kept, line)
 	}
 
-	// Check if footer is present
-	if !strings.Contains(content, "linkedin.com/in/qa-lead-rostyslav-chabria") {
-		// Add footer after content
-		content = strings.TrimSpace(content) + "\n\n" + footer
+	return strings.Join(kept, "\n")
+}
+
+// splitHeader splits the post into the header line (plus the blank lines after it) and the body
+func splitHeader(content string) (string, string) {
+	idx := strings.Index(content, "\n")
+	if idx == -1 {
+		return "", content
+	}
+	body := strings.TrimLeft(content[idx+1:], "\n")
+	return content[:len(content)-len(body)], body
+}
+
+// hookOpenerWords is how many leading words of a hook identify its opener
+const hookOpenerWords = 5
+
+// HookOpener returns the first few words of a hook, which identify the hook formula used
+func HookOpener(hook string) string {
+	words := strings.Fields(hook)
+	if len(words) > hookOpenerWords {
+		words = words[:hookOpenerWords]
+	}
+	return strings.Join(words, " ")
+}
+
+// ExtractHook returns the actual hook of a post: the first line of the body after the header
+func ExtractHook(content string) string {
+	_, body := splitHeader(content)
+	if idx := strings.Index(body, "\n"); idx != -1 {
+		body = body[:idx]
+	}
+	return strings.TrimSpace(body)
+}
+
+// HookFitsFold reports whether the hook (the first paragraph after the header line)
+// ends within the first foldLength characters of the post, i.e. before LinkedIn's
+// "see more" cutoff. A non-positive foldLength disables the check.
+func HookFitsFold(content string, foldLength int) bool {
+	if foldLength <= 0 {
+		return true
+	}
+
+	hook := ExtractHook(content)
+	if hook == "" {
+		return false
+	}
+
+	header, _ := splitHeader(content)
+	hookEnd := utf8.RuneCountInString(header) + utf8.RuneCountInString(hook)
+	return hookEnd <= foldLength
+}
+
+// reconcileHook returns the hook actually present in the content, warning when it
+// diverges from the hook the model reported
+func (c *Client) reconcileHook(content, reportedHook string) string {
+	actual := ExtractHook(content)
+	if actual == "" {
+		return reportedHook
+	}
+
+	normalize := func(s string) string {
+		return strings.ToLower(strings.Join(strings.Fields(s), " "))
+	}
+	a, r := normalize(actual), normalize(reportedHook)
+	if r != "" && !strings.Contains(a, r) && !strings.Contains(r, a) {
+		c.log.Warn().
+			Str("reported_hook", reportedHook).
+			Str("actual_hook", actual).
+			Msg("Reported hook does not match the content, using the actual first line")
+	}
+
+	return actual
+}
+
+// statisticPattern matches statistical claims: percentages ("80%", "12 percent"), multipliers
+// ("3.5x"), amounts ("$2B", "€40") and scaled counts ("1.2M", "3 million"). Plain numbers
+// such as versions, years or "5 tips" are not claims.
+var statisticPattern = regexp.MustCompile(`[$€£]\d|\b\d[\d,.]*(\s*(%|percent\b|[kKMB]\b|bn\b|thousand\b|million\b|billion\b|trillion\b)|x\b)`)
+
+// ExtractStatisticClaims returns the sentences in the post body that contain numeric or
+// statistical claims, so they can be verified before publishing. The header line and
+// footer links are ignored.
+func ExtractStatisticClaims(content string) []string {
+	lines := strings.Split(content, "\n")
+	if len(lines) > 0 {
+		lines = lines[1:] // Skip header (contains the date)
+	}
+
+	var claims []string
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || line == "---" || strings.HasPrefix(line, "#") ||
+			strings.HasPrefix(line, "LinkedIn:") || strings.HasPrefix(line, "Instagram:") {
+			continue
+		}
+
+		for _, sentence := range splitSentences(line) {
+			if statisticPattern.MatchString(sentence) {
+				claims = append(claims, sentence)
+			}
+		}
+	}
+
+	return claims
+}
+
+// splitSentences splits a line into rough sentences on terminal punctuation
+func splitSentences(line string) []string {
+	var sentences []string
+	start := 0
+	for i, r := range line {
+		if (r == '.' || r == '!' || r == '?') && (i+1 == len(line) || line[i+1] == ' ') {
+			if sentence := strings.TrimSpace(line[start : i+1]); sentence != "" {
+				sentences = append(sentences, sentence)
+			}
+			start = i + 1
+		}
+	}
+	if rest := strings.TrimSpace(line[start:]); rest != "" {
+		sentences = append(sentences, rest)
+	}
+	return sentences
+}
+
+// MaxHashtags is the most hashtags kept on a post
+const MaxHashtags = 5
+
+// NormalizeHashtags trims whitespace, lowercases, ensures a single leading '#', drops
+// duplicates and keeps at most MaxHashtags tags
+func NormalizeHashtags(tags []string) []string {
+	seen := make(map[string]bool)
+	normalized := make([]string, 0, min(len(tags), MaxHashtags))
+
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(tag), "")
+		tag = strings.ToLower(strings.TrimLeft(tag, "#"))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, "#"+tag)
+		if len(normalized) == MaxHashtags {
+			break
+		}
+	}
+
+	return normalized
+}
+
+// AppendHashtags appends the tags to the content as a hashtag line, skipping any
+// tag already present in the content (case-insensitive) and stopping once the
+// post carries MaxHashtags tags
+func AppendHashtags(content string, tags []string) string {
+	present := make(map[string]bool)
+	for _, word := range strings.Fields(content) {
+		if strings.HasPrefix(word, "#") {
+			present[strings.ToLower(strings.TrimRight(word, ".,!?;:"))] = true
+		}
 	}
 
-	return content
+	var missing []string
+	for _, tag := range NormalizeHashtags(tags) {
+		if len(present)+len(missing) >= MaxHashtags {
+			break
+		}
+		if !present[tag] {
+			missing = append(missing, tag)
+		}
+	}
+
+	if len(missing) == 0 {
+		return content
+	}
+	return content + "\n\n" + strings.Join(missing, " ")
+}
+
+// dedupeHashtagLines normalizes lines that consist only of hashtags: tags are
+// lowercased, duplicates across lines are removed and at most MaxHashtags are kept
+// (e.g. "#AI #Cloud #ai" -> "#ai #cloud")
+func dedupeHashtagLines(content string) string {
+	seen := make(map[string]bool)
+	lines := strings.Split(content, "\n")
+
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		allTags := true
+		for _, f := range fields {
+			if !strings.HasPrefix(f, "#") {
+				allTags = false
+				break
+			}
+		}
+		if !allTags {
+			continue
+		}
+
+		kept := make([]string, 0, len(fields))
+		for _, tag := range NormalizeHashtags(fields) {
+			if !seen[tag] && len(seen) < MaxHashtags {
+				seen[tag] = true
+				kept = append(kept, tag)
+			}
+		}
+		lines[i] = strings.Join(kept, " ")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// ContentOptions customizes a single content generation
+type ContentOptions struct {
+	Template        string              // Post skeleton with {placeholders}; {topic_title} is filled in before sending
+	AvoidOpeners    []string            // Openers of recent hooks the new hook should not repeat
+	Author          config.AuthorConfig // Identity for the header and footer
+	TargetWordCount int                 // Approximate post length in words (0 = default)
+	MaxCharacters   int                 // Character budget for the whole post (0 = LinkedIn's limit)
+	PreviousDraft   string              // Draft being revised; used together with Feedback
+	Feedback        string              // Reviewer feedback the revised post should follow
+}
+
+// Post length defaults used when ContentOptions leaves them unset
+const (
+	defaultTargetWordCount = 275
+	defaultMaxCharacters   = 3000
+)
+
+// contentSystemPrompt fills the content generation system prompt with the voice, length budget and author
+func contentSystemPrompt(brandVoice string, opts ContentOptions) string {
+	wordCount := opts.TargetWordCount
+	if wordCount <= 0 {
+		wordCount = defaultTargetWordCount
+	}
+	maxChars := opts.MaxCharacters
+	if maxChars <= 0 || maxChars > defaultMaxCharacters {
+		maxChars = defaultMaxCharacters
+	}
+	return newAuthorIdentity(opts.Author).applyToPrompt(fmt.Sprintf(ContentGenerationSystemPrompt, brandVoice, wordCount, maxChars))
 }
 
 // GenerateContent creates LinkedIn post content for a topic
-func (c *Client) GenerateContent(ctx context.Context, topic *models.Topic, brandVoice string) (*GeneratedContent, error) {
-	systemPrompt := fmt.Sprintf(ContentGenerationSystemPrompt, brandVoice)
+func (c *Client) GenerateContent(ctx context.Context, topic *models.Topic, brandVoice string, opts ContentOptions) (*GeneratedContent, error) {
+	author := newAuthorIdentity(opts.Author)
+	systemPrompt := contentSystemPrompt(brandVoice, opts)
 
 	// Get suggested angle from AI metadata if available
 	suggestedAngle := ""
@@ -183,32 +575,48 @@ func (c *Client) GenerateContent(ctx context.Context, topic *models.Topic, brand
 		}
 	}
 
-	userPrompt := fmt.Sprintf(ContentGenerationUserPrompt,
+	userPrompt := author.applyToPrompt(fmt.Sprintf(ContentGenerationUserPrompt,
 		topic.Title,
 		suggestedAngle,
 		topic.Description,
-	)
+	))
+
+	if opts.Template != "" {
+		template := strings.ReplaceAll(opts.Template, "{topic_title}", topic.Title)
+		userPrompt += fmt.Sprintf(ContentTemplateInstruction, template)
+	}
+
+	if len(opts.AvoidOpeners) > 0 {
+		userPrompt += fmt.Sprintf(AvoidOpenersInstruction, "- "+strings.Join(opts.AvoidOpeners, "\n- "))
+	}
 
-	response, err := c.CompleteWithJSON(ctx, systemPrompt, userPrompt)
+	if opts.Feedback != "" {
+		userPrompt += fmt.Sprintf(RevisionInstruction, opts.PreviousDraft, opts.Feedback)
+	}
+
+	response, err := c.CompleteWithJSON(ctx, systemPrompt, userPrompt, c.contentOpts()...)
 	if err != nil {
 		return nil, err
 	}
 
 	var content GeneratedContent
-	if err := json.Unmarshal([]byte(stripMarkdownCodeBlock(response)), &content); err != nil {
+	if response, err = c.parseJSON(ctx, systemPrompt, userPrompt, response, &content, c.contentOpts()...); err != nil {
 		c.log.Error().
 			Err(err).
 			Str("response", response).
 			Msg("Failed to parse content response")
 		return nil, fmt.Errorf("failed to parse content response: %w", err)
 	}
+	content.Raw = c.rawResponse(systemPrompt, userPrompt, response)
 
 	// Post-process to ensure header and footer are present
-	content.Content = postProcessContent(content.Content)
+	content.Content = postProcessContent(dedupeHashtagLines(content.Content), author)
+	content.Hashtags = NormalizeHashtags(content.Hashtags)
+	content.Hook = c.reconcileHook(content.Content, content.Hook)
 	c.log.Info().
 		Str("content_start", content.Content[:min(60, len(content.Content))]).
-		Bool("has_header", strings.HasPrefix(content.Content, "Tech Insights")).
-		Bool("has_footer", strings.Contains(content.Content, "linkedin.com/in/qa-lead")).
+		Bool("has_header", strings.HasPrefix(content.Content, author.header)).
+		Bool("has_footer", author.hasFooter(content.Content)).
 		Msg("Post-processed content")
 
 	return &content, nil
@@ -223,22 +631,23 @@ func min(a, b int) int {
 
 // GeneratedPoll represents an AI-generated LinkedIn poll
 type GeneratedPoll struct {
-	Question  string   `json:"question"`
-	Options   []string `json:"options"`
-	IntroText string   `json:"intro_text"`
-	Hashtags  []string `json:"hashtags"`
+	Question  string       `json:"question"`
+	Options   []string     `json:"options"`
+	IntroText string       `json:"intro_text"`
+	Hashtags  []string     `json:"hashtags"`
+	Raw       *RawResponse `json:"-"`
 }
 
 // GeneratePoll creates a LinkedIn poll for a topic
-func (c *Client) GeneratePoll(ctx context.Context, topic *models.Topic, brandVoice string) (*GeneratedPoll, error) {
-	systemPrompt := fmt.Sprintf(ContentGenerationSystemPrompt, brandVoice)
+func (c *Client) GeneratePoll(ctx context.Context, topic *models.Topic, brandVoice string, opts ContentOptions) (*GeneratedPoll, error) {
+	systemPrompt := contentSystemPrompt(brandVoice, opts)
 
 	userPrompt := fmt.Sprintf(PollGenerationUserPrompt,
 		topic.Title,
 		topic.Description,
 	)
 
-	response, err := c.CompleteWithJSON(ctx, systemPrompt, userPrompt)
+	response, err := c.CompleteWithJSON(ctx, systemPrompt, userPrompt, c.contentOpts()...)
 	if err != nil {
 		return nil, err
 	}
@@ -251,6 +660,7 @@ func (c *Client) GeneratePoll(ctx context.Context, topic *models.Topic, brandVoi
 			Msg("Failed to parse poll response")
 		return nil, fmt.Errorf("failed to parse poll response: %w", err)
 	}
+	poll.Raw = c.rawResponse(systemPrompt, userPrompt, response)
 
 	return &poll, nil
 }
@@ -338,54 +748,46 @@ func (c *Client) GenerateImageSearchKeywords(ctx context.Context, topic *models.
 
 // GeneratedDigest represents an AI-generated daily news digest
 type GeneratedDigest struct {
-	Content  string   `json:"content"`
-	Hashtags []string `json:"hashtags"`
-	Hook     string   `json:"hook"`
-	CTA      string   `json:"cta"`
+	Content  string       `json:"content"`
+	Hashtags []string     `json:"hashtags"`
+	Hook     string       `json:"hook"`
+	CTA      string       `json:"cta"`
+	Raw      *RawResponse `json:"-"`
 }
 
 // postProcessDigestContent ensures header and footer are present with correct date
-func postProcessDigestContent(content string) string {
+func postProcessDigestContent(content string, author authorIdentity) string {
 	now := time.Now()
 	today := now.Format("Jan 2, 2006")
 
 	// Use "Morning Updates" before noon, "Nightly Updates" after noon
-	headerPrefix := "Morning Updates from Ros"
+	headerPrefix := author.titled("Morning Updates")
 	if now.Hour() >= 12 {
-		headerPrefix = "Nightly Updates from Ros"
+		headerPrefix = author.titled("Nightly Updates")
 	}
 	header := headerPrefix + " | " + today
-	footer := "---\nLinkedIn: https://www.linkedin.com/in/qa-lead-rostyslav-chabria/\nInstagram: https://www.instagram.com/rostislav_cha"
 
 	// Replace unicode box-drawing characters with simple dashes
 	content = strings.ReplaceAll(content, "━", "-")
 
 	// Fix header with correct date - check for various header formats
-	if strings.HasPrefix(content, "Daily Updates from Ros") ||
-		strings.HasPrefix(content, "Morning Updates from Ros") ||
-		strings.HasPrefix(content, "Nightly Updates from Ros") {
-		if idx := strings.Index(content, "\n"); idx != -1 {
-			content = header + content[idx:]
-		}
-	} else {
-		content = header + "\n\n" + content
-	}
-
-	// Ensure footer is present
-	if !strings.Contains(content, "linkedin.com/in/qa-lead-rostyslav-chabria") {
-		content = strings.TrimSpace(content) + "\n\n" + footer
-	}
+	content = replaceHeader(content, header,
+		author.titled("Daily Updates"),
+		author.titled("Morning Updates"),
+		author.titled("Nightly Updates"),
+	)
 
-	return content
+	return author.appendFooter(content)
 }
 
 // GenerateDigest creates a daily news digest post from top 3 topics
-func (c *Client) GenerateDigest(ctx context.Context, topics []DigestTopic, brandVoice string) (*GeneratedDigest, error) {
+func (c *Client) GenerateDigest(ctx context.Context, topics []DigestTopic, brandVoice string, authorCfg config.AuthorConfig) (*GeneratedDigest, error) {
 	if len(topics) < 3 {
 		return nil, fmt.Errorf("digest requires at least 3 topics, got %d", len(topics))
 	}
 
-	systemPrompt := fmt.Sprintf(DigestGenerationSystemPrompt, brandVoice)
+	author := newAuthorIdentity(authorCfg)
+	systemPrompt := author.applyToPrompt(fmt.Sprintf(DigestGenerationSystemPrompt, brandVoice))
 
 	userPrompt := fmt.Sprintf(DigestGenerationUserPrompt,
 		topics[0].Title, topics[0].Description, topics[0].Source,
@@ -393,41 +795,163 @@ func (c *Client) GenerateDigest(ctx context.Context, topics []DigestTopic, brand
 		topics[2].Title, topics[2].Description, topics[2].Source,
 	)
 
-	response, err := c.CompleteWithJSON(ctx, systemPrompt, userPrompt)
+	response, err := c.CompleteWithJSON(ctx, systemPrompt, userPrompt, c.contentOpts()...)
 	if err != nil {
 		return nil, err
 	}
 
 	var digest GeneratedDigest
-	if err := json.Unmarshal([]byte(stripMarkdownCodeBlock(response)), &digest); err != nil {
+	if response, err = c.parseJSON(ctx, systemPrompt, userPrompt, response, &digest, c.contentOpts()...); err != nil {
 		c.log.Error().
 			Err(err).
 			Str("response", response).
 			Msg("Failed to parse digest response")
 		return nil, fmt.Errorf("failed to parse digest response: %w", err)
 	}
+	digest.Raw = c.rawResponse(systemPrompt, userPrompt, response)
 
 	// Post-process to ensure correct date in header and footer
-	digest.Content = postProcessDigestContent(digest.Content)
+	digest.Content = postProcessDigestContent(dedupeHashtagLines(digest.Content), author)
+	digest.Hashtags = NormalizeHashtags(digest.Hashtags)
+	digest.Hook = c.reconcileHook(digest.Content, digest.Hook)
 
 	return &digest, nil
 }
 
+// postProcessRecapContent ensures the weekly recap header and footer are present with the correct date
+func postProcessRecapContent(content string, author authorIdentity) string {
+	headerPrefix := author.titled("Week in Tech")
+	content = replaceHeader(content, headerPrefix+" | "+time.Now().Format("Jan 2, 2006"), headerPrefix)
+	return author.appendFooter(content)
+}
+
+// GenerateWeeklyRecap creates a "week in tech" recap post from the week's top stories
+func (c *Client) GenerateWeeklyRecap(ctx context.Context, stories []DigestTopic, brandVoice string, authorCfg config.AuthorConfig) (*GeneratedDigest, error) {
+	if len(stories) < 3 {
+		return nil, fmt.Errorf("weekly recap requires at least 3 stories, got %d", len(stories))
+	}
+
+	var list strings.Builder
+	for i, story := range stories {
+		fmt.Fprintf(&list, "STORY %d:\nTitle: %s\nSummary: %s\nSource: %s\n\n", i+1, story.Title, story.Description, story.Source)
+	}
+
+	author := newAuthorIdentity(authorCfg)
+	systemPrompt := author.applyToPrompt(fmt.Sprintf(WeeklyRecapSystemPrompt, brandVoice))
+	userPrompt := fmt.Sprintf(WeeklyRecapUserPrompt, list.String())
+
+	response, err := c.CompleteWithJSON(ctx, systemPrompt, userPrompt, c.contentOpts()...)
+	if err != nil {
+		return nil, err
+	}
+
+	var recap GeneratedDigest
+	if err := json.Unmarshal([]byte(stripMarkdownCodeBlock(response)), &recap); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("response", response).
+			Msg("Failed to parse weekly recap response")
+		return nil, fmt.Errorf("failed to parse weekly recap response: %w", err)
+	}
+	recap.Raw = c.rawResponse(systemPrompt, userPrompt, response)
+
+	recap.Content = postProcessRecapContent(dedupeHashtagLines(recap.Content), author)
+	recap.Hashtags = NormalizeHashtags(recap.Hashtags)
+	recap.Hook = c.reconcileHook(recap.Content, recap.Hook)
+
+	return &recap, nil
+}
+
+// ArticleSection is one headed section of a long-form article
+type ArticleSection struct {
+	Heading string `json:"heading"`
+	Body    string `json:"body"`
+}
+
+// GeneratedArticle represents an AI-generated long-form article
+type GeneratedArticle struct {
+	Title    string           `json:"title"`
+	Subtitle string           `json:"subtitle"`
+	Sections []ArticleSection `json:"sections"`
+	Teaser   string           `json:"teaser"`
+	Hashtags []string         `json:"hashtags"`
+	Raw      *RawResponse     `json:"-"`
+}
+
+// Body renders the article sections as plain text, ready to paste into LinkedIn's article editor
+func (a *GeneratedArticle) Body() string {
+	var b strings.Builder
+	for i, section := range a.Sections {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString(strings.TrimSpace(section.Heading))
+		b.WriteString("\n\n")
+		b.WriteString(strings.TrimSpace(section.Body))
+	}
+	return b.String()
+}
+
+// GenerateArticle creates a titled, multi-section long-form article from a cluster of topics
+func (c *Client) GenerateArticle(ctx context.Context, topics []DigestTopic, brandVoice string) (*GeneratedArticle, error) {
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("article requires at least 1 topic")
+	}
+
+	var list strings.Builder
+	for i, topic := range topics {
+		fmt.Fprintf(&list, "STORY %d:\nTitle: %s\nDescription: %s\nSource: %s\n\n", i+1, topic.Title, topic.Description, topic.Source)
+	}
+
+	systemPrompt := fmt.Sprintf(ArticleGenerationSystemPrompt, brandVoice)
+	userPrompt := fmt.Sprintf(ArticleGenerationUserPrompt, list.String())
+
+	response, err := c.CompleteWithJSON(ctx, systemPrompt, userPrompt, c.contentOpts()...)
+	if err != nil {
+		return nil, err
+	}
+
+	var article GeneratedArticle
+	if err := json.Unmarshal([]byte(stripMarkdownCodeBlock(response)), &article); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("response", response).
+			Msg("Failed to parse article response")
+		return nil, fmt.Errorf("failed to parse article response: %w", err)
+	}
+	if article.Title == "" || len(article.Sections) == 0 {
+		return nil, fmt.Errorf("article response is missing a title or sections")
+	}
+	article.Raw = c.rawResponse(systemPrompt, userPrompt, response)
+	article.Hashtags = NormalizeHashtags(article.Hashtags)
+
+	c.log.Debug().
+		Str("title", article.Title).
+		Int("sections", len(article.Sections)).
+		Msg("Generated article")
+
+	return &article, nil
+}
+
 // GeneratedComment represents an AI-generated LinkedIn comment
 type GeneratedComment struct {
 	Comment   string `json:"comment"`
 	Reasoning string `json:"reasoning"`
+	Quote     string `json:"quote,omitempty"` // Text from the post the comment refers to (when quoting)
 }
 
 // GenerateComment creates a contextual comment for a LinkedIn post
-func (c *Client) GenerateComment(ctx context.Context, authorName, postContent, commentStyle string) (*GeneratedComment, error) {
+func (c *Client) GenerateComment(ctx context.Context, authorName, postContent, commentStyle string, quoteTarget bool) (*GeneratedComment, error) {
 	if commentStyle == "" {
 		commentStyle = "insightful"
 	}
 
 	userPrompt := fmt.Sprintf(CommentGenerationUserPrompt, commentStyle, authorName, postContent)
+	if quoteTarget {
+		userPrompt += CommentQuoteInstruction
+	}
 
-	response, err := c.CompleteWithJSON(ctx, CommentGenerationSystemPrompt, userPrompt)
+	response, err := c.CompleteWithJSON(ctx, CommentGenerationSystemPrompt, userPrompt, c.contentOpts()...)
 	if err != nil {
 		return nil, err
 	}
@@ -449,3 +973,77 @@ func (c *Client) GenerateComment(ctx context.Context, authorName, postContent, c
 
 	return &comment, nil
 }
+
+// QuoteAppearsIn reports whether quote occurs in content, ignoring case, whitespace
+// differences and surrounding quotation marks
+func QuoteAppearsIn(quote, content string) bool {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.Join(strings.Fields(s), " "))
+	}
+
+	quote = normalize(strings.Trim(strings.TrimSpace(quote), "\"'“”‘’."))
+	if quote == "" {
+		return false
+	}
+	return strings.Contains(normalize(content), quote)
+}
+
+// JaccardSimilarity returns the Jaccard index (0-1) of the lowercased word sets of a and b.
+// Punctuation is ignored, so "AI," and "ai" count as the same word.
+func JaccardSimilarity(a, b string) float64 {
+	words := func(s string) map[string]bool {
+		set := make(map[string]bool)
+		for _, w := range strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			set[w] = true
+		}
+		return set
+	}
+
+	setA, setB := words(a), words(b)
+	if len(setA) == 0 && len(setB) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for w := range setA {
+		if setB[w] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(setA)+len(setB)-shared)
+}
+
+// CommentReview represents the AI's self-review of a generated comment
+type CommentReview struct {
+	Score          float64 `json:"score"`
+	Feedback       string  `json:"feedback"`
+	RevisedComment string  `json:"revised_comment"`
+}
+
+// ReviewComment scores a draft comment for relevance and authenticity, rewriting it if needed
+func (c *Client) ReviewComment(ctx context.Context, authorName, postContent, comment string) (*CommentReview, error) {
+	userPrompt := fmt.Sprintf(CommentReviewUserPrompt, authorName, postContent, comment)
+
+	response, err := c.CompleteWithJSON(ctx, CommentReviewSystemPrompt, userPrompt)
+	if err != nil {
+		return nil, err
+	}
+
+	var review CommentReview
+	if err := json.Unmarshal([]byte(stripMarkdownCodeBlock(response)), &review); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("response", response).
+			Msg("Failed to parse comment review response")
+		return nil, fmt.Errorf("failed to parse comment review response: %w", err)
+	}
+
+	c.log.Debug().
+		Float64("score", review.Score).
+		Bool("revised", review.RevisedComment != "").
+		Msg("Reviewed comment")
+
+	return &review, nil
+}
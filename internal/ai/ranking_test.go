@@ -0,0 +1,65 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkedin-agent/internal/config"
+)
+
+func TestNormalizeHashtagsMixedCaseDuplicates(t *testing.T) {
+	got := NormalizeHashtags([]string{"#AI", "ai", " #Cloud", "##ai", "#CLOUD", "DevOps", "#"})
+	want := []string{"#ai", "#cloud", "#devops"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("NormalizeHashtags = %v, want %v", got, want)
+	}
+}
+
+func TestDedupeHashtagLinesMixedCase(t *testing.T) {
+	content := "Body mentions #ai inline.\n\n#AI #Cloud #ai\n#cloud #DevOps"
+	want := "Body mentions #ai inline.\n\n#ai #cloud\n#devops"
+	if got := dedupeHashtagLines(content); got != want {
+		t.Errorf("dedupeHashtagLines =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestAppendHashtagsSkipsTagsInContent(t *testing.T) {
+	content := "Kubernetes keeps growing. #kubernetes"
+	want := content + "\n\n#ai"
+	if got := AppendHashtags(content, []string{"#Kubernetes", "#AI", "#ai"}); got != want {
+		t.Errorf("AppendHashtags =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestPostProcessContentMovesMisplacedHeader(t *testing.T) {
+	author := newAuthorIdentity(config.AuthorConfig{DisplayName: "Ros"})
+	// The model put an outdated header below the hook instead of on the first line
+	content := "Kubernetes 2.0 is here.\n\nTech Insights from Ros | Jan 1, 2020\n\nHere is what changed."
+
+	got := postProcessContent(content, author)
+
+	header := "Tech Insights from Ros | " + time.Now().Format("Jan 2, 2006")
+	want := header + "\n\nKubernetes 2.0 is here.\n\nHere is what changed."
+	if got != want {
+		t.Errorf("postProcessContent =\n%q\nwant\n%q", got, want)
+	}
+	if n := strings.Count(got, "Tech Insights from Ros"); n != 1 {
+		t.Errorf("header appears %d times, want once", n)
+	}
+}
+
+func TestExtractStatisticClaimsSkipsPlainNumbers(t *testing.T) {
+	content := "Tech Insights from Ros | Jan 2, 2026\n\n" +
+		"Go 1.25 shipped in 2025 with 5 new features. Builds got 2x faster.\n" +
+		"Adoption rose 40% this year. The company raised $30M.\n" +
+		"Over 3 million developers use it! I tried 3 of them.\n\n" +
+		"#golang"
+
+	got := ExtractStatisticClaims(content)
+	want := []string{"Builds got 2x faster.", "Adoption rose 40% this year.", "The company raised $30M.", "Over 3 million developers use it!"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("ExtractStatisticClaims =\n%q\nwant\n%q", got, want)
+	}
+}
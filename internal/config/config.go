@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
+	_ "time/tzdata" // Time zone database for hosts without one (e.g. slim containers)
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/viper"
@@ -15,6 +17,7 @@ type Config struct {
 	LinkedIn   LinkedInConfig   `mapstructure:"linkedin"`
 	Anthropic  AnthropicConfig  `mapstructure:"anthropic"`
 	Sources    SourcesConfig    `mapstructure:"sources"`
+	Discovery  DiscoveryConfig  `mapstructure:"discovery"`
 	Scheduler  SchedulerConfig  `mapstructure:"scheduler"`
 	RateLimit  RateLimitConfig  `mapstructure:"rate_limit"`
 	Logging    LoggingConfig    `mapstructure:"logging"`
@@ -40,6 +43,10 @@ type LinkedInConfig struct {
 	AccessToken    string `mapstructure:"access_token"`
 	RefreshToken   string `mapstructure:"refresh_token"`
 	TokenExpiresAt string `mapstructure:"token_expires_at"`
+	// Scheduler warns when the token expires within this many days (0 = disabled)
+	ExpiryWarningDays int `mapstructure:"expiry_warning_days"`
+	// API host override for proxies or mock servers (empty = api.linkedin.com)
+	APIBaseURL string `mapstructure:"api_base_url"`
 }
 
 // AnthropicConfig holds Claude API settings
@@ -48,15 +55,46 @@ type AnthropicConfig struct {
 	Model       string  `mapstructure:"model"`
 	MaxTokens   int     `mapstructure:"max_tokens"`
 	Temperature float64 `mapstructure:"temperature"`
+	// Per-task overrides (nil = use temperature; 0 is honored, for deterministic output)
+	RankingTemperature *float64 `mapstructure:"ranking_temperature"` // Low for consistent scoring
+	ContentTemperature *float64 `mapstructure:"content_temperature"` // Higher for creative writing
+	// Per-task model overrides (empty = use model)
+	RankingModel string `mapstructure:"ranking_model"` // e.g. a cheaper model for bulk scoring
+	ContentModel string `mapstructure:"content_model"`
+	// Topics scored per ranking request; lower it when long descriptions overrun max_tokens
+	RankBatchSize int `mapstructure:"rank_batch_size"`
+	// Re-ask once when a ranking/content/digest response is not valid JSON
+	RetryInvalidJSON bool `mapstructure:"retry_invalid_json"`
+	// API host override for proxies or API-compatible endpoints (empty = SDK default)
+	BaseURL string `mapstructure:"base_url"`
+	// Debugging
+	SaveRawResponses bool `mapstructure:"save_raw_responses"` // Persist raw responses and prompts in post AIMetadata
+	// Pricing for usage cost estimates (USD per million tokens)
+	InputPricePerMTok  float64 `mapstructure:"input_price_per_mtok"`
+	OutputPricePerMTok float64 `mapstructure:"output_price_per_mtok"`
+	// Daily caps shared by every process using the repository, enforced before each request (0 = unlimited)
+	MaxRequestsPerDay int `mapstructure:"max_requests_per_day"`
+	MaxTokensPerDay   int `mapstructure:"max_tokens_per_day"`
 }
 
 // SourcesConfig holds all topic source configurations
 type SourcesConfig struct {
-	NewsAPI NewsAPIConfig `mapstructure:"newsapi"`
-	RSS     RSSConfig     `mapstructure:"rss"`
-	Twitter TwitterConfig `mapstructure:"twitter"`
-	Reddit  RedditConfig  `mapstructure:"reddit"`
-	Custom  CustomConfig  `mapstructure:"custom"`
+	NewsAPI    NewsAPIConfig    `mapstructure:"newsapi"`
+	RSS        RSSConfig        `mapstructure:"rss"`
+	Twitter    TwitterConfig    `mapstructure:"twitter"`
+	Reddit     RedditConfig     `mapstructure:"reddit"`
+	HackerNews HackerNewsConfig `mapstructure:"hackernews"`
+	Custom     CustomConfig     `mapstructure:"custom"`
+
+	MaxConcurrency int    `mapstructure:"max_concurrency"` // Sources fetched at the same time (0 = all at once)
+	FetchTimeout   string `mapstructure:"fetch_timeout"`   // Give up on a source that hasn't finished fetching after this long (0 = no limit)
+}
+
+// FetchTimeoutDuration returns the per-source fetch timeout (0 = no limit).
+// Load has already rejected invalid durations.
+func (s SourcesConfig) FetchTimeoutDuration() time.Duration {
+	d, _ := time.ParseDuration(s.FetchTimeout)
+	return d
 }
 
 // NewsAPIConfig holds NewsAPI settings
@@ -73,12 +111,15 @@ type RSSConfig struct {
 	Enabled       bool       `mapstructure:"enabled"`
 	Feeds         []RSSFeed  `mapstructure:"feeds"`
 	FetchInterval string     `mapstructure:"fetch_interval"`
+	MaxAge        string     `mapstructure:"max_age"` // Skip items published longer ago than this (duration, e.g. "24h")
+	FetchFullText bool       `mapstructure:"fetch_full_text"` // Fetch the linked article when an item's description is thin
 }
 
 // RSSFeed represents a single RSS feed
 type RSSFeed struct {
-	Name string `mapstructure:"name"`
-	URL  string `mapstructure:"url"`
+	Name   string  `mapstructure:"name"`
+	URL    string  `mapstructure:"url"`
+	Weight float64 `mapstructure:"weight"` // Multiplier applied to this feed's topic scores (0 = 1.0)
 }
 
 // TwitterConfig holds Twitter/X API settings
@@ -98,19 +139,68 @@ type RedditConfig struct {
 	FetchInterval string   `mapstructure:"fetch_interval"`
 }
 
+// HackerNewsConfig holds Hacker News API settings
+type HackerNewsConfig struct {
+	Enabled    bool   `mapstructure:"enabled"`
+	Feed       string `mapstructure:"feed"`        // "top" or "best"
+	MaxStories int    `mapstructure:"max_stories"` // Stories to fetch per run
+	MinScore   int    `mapstructure:"min_score"`   // Skip stories below this HN score
+}
+
 // CustomConfig holds custom keyword settings
 type CustomConfig struct {
 	Enabled  bool     `mapstructure:"enabled"`
 	Keywords []string `mapstructure:"keywords"`
 }
 
+// DiscoveryConfig holds topic discovery filtering settings
+type DiscoveryConfig struct {
+	AllowedDomains  []string `mapstructure:"allowed_domains"`            // Only keep topics from these domains (empty = all)
+	BlockedDomains  []string `mapstructure:"blocked_domains"`            // Always drop topics from these domains
+	TitleSimilarity float64  `mapstructure:"title_similarity_threshold"` // Collapse topics whose titles overlap at least this much, 0-1 (0 = off)
+}
+
 // SchedulerConfig holds scheduler settings
 type SchedulerConfig struct {
-	DiscoveryCron string   `mapstructure:"discovery_cron"`
-	DigestCron    string   `mapstructure:"digest_cron"`
-	PublishCron   string   `mapstructure:"publish_cron"`    // Single cron (backward compat)
-	PublishCrons  []string `mapstructure:"publish_crons"`   // Multiple publish windows
-	CleanupCron   string   `mapstructure:"cleanup_cron"`
+	DiscoveryCron     string   `mapstructure:"discovery_cron"`
+	DigestCron        string   `mapstructure:"digest_cron"`
+	PublishCron       string   `mapstructure:"publish_cron"`  // Single cron (backward compat)
+	PublishCrons      []string `mapstructure:"publish_crons"` // Multiple publish windows
+	CleanupCron       string   `mapstructure:"cleanup_cron"`
+	CleanupFixOrphans bool     `mapstructure:"cleanup_fix_orphans"`  // Clear dangling post/comment references during cleanup
+	CleanupMaxAgeDays int      `mapstructure:"cleanup_max_age_days"` // Delete unused topics and exhausted failed posts older than this (0 = keep)
+	WeeklyRecapCron   string   `mapstructure:"weekly_recap_cron"`    // Weekly recap generation (empty = disabled)
+	PublishOnStart    bool     `mapstructure:"publish_on_start"`     // Publish due posts immediately on daemon startup
+	PollResultsCron   string   `mapstructure:"poll_results_cron"`    // Collect results of closed polls (empty = disabled)
+	AnalyticsCron     string   `mapstructure:"analytics_cron"`       // Sync engagement of recent posts (empty = disabled)
+	Timezone          string   `mapstructure:"timezone"`             // IANA time zone for crons and --at times, e.g. "Europe/Kyiv" (empty = server time)
+	NotifyWebhookURL  string   `mapstructure:"notify_webhook_url"`   // Slack-compatible webhook for alerts such as an expiring token (empty = log only)
+}
+
+// Location returns the scheduler's time zone
+func (s SchedulerConfig) Location() *time.Location {
+	return loadLocation(s.Timezone)
+}
+
+// PublishWindows returns the publish crons, falling back to the single publish_cron
+func (s SchedulerConfig) PublishWindows() []string {
+	if len(s.PublishCrons) == 0 {
+		return []string{s.PublishCron}
+	}
+	return s.PublishCrons
+}
+
+// loadLocation loads an IANA time zone, using the server's local time when name is empty.
+// Load has already rejected invalid names.
+func loadLocation(name string) *time.Location {
+	if name == "" {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.Local
+	}
+	return loc
 }
 
 // RateLimitConfig holds rate limiting settings
@@ -118,6 +208,7 @@ type RateLimitConfig struct {
 	LinkedInRequestsPerDay     int `mapstructure:"linkedin_requests_per_day"`
 	AnthropicRequestsPerMinute int `mapstructure:"anthropic_requests_per_minute"`
 	SourceRequestsPerHour      int `mapstructure:"source_requests_per_hour"`
+	LinkedInMaxRetries         int `mapstructure:"linkedin_max_retries"` // Retries with backoff on LinkedIn 429s and 5xx on reads (0 = off)
 }
 
 // LoggingConfig holds logging settings
@@ -129,11 +220,44 @@ type LoggingConfig struct {
 
 // PublishingConfig holds publishing settings
 type PublishingConfig struct {
-	AutoApprove       bool    `mapstructure:"auto_approve"`
-	MaxPostsPerDay    int     `mapstructure:"max_posts_per_day"`
-	MinScoreThreshold float64 `mapstructure:"min_score_threshold"`
-	DefaultPostType   string  `mapstructure:"default_post_type"`
-	BrandVoice        string  `mapstructure:"brand_voice"`
+	AutoApprove                bool              `mapstructure:"auto_approve"`
+	MaxPostsPerDay             int               `mapstructure:"max_posts_per_day"`
+	MinScoreThreshold          float64           `mapstructure:"min_score_threshold"`
+	DefaultPostType            string            `mapstructure:"default_post_type"`
+	BrandVoice                 string            `mapstructure:"brand_voice"`
+	HookFoldLength             int               `mapstructure:"hook_fold_length"`                // Characters visible before "see more"
+	MaxDraftAgeDays            int               `mapstructure:"max_draft_age_days"`              // Delete unapproved drafts older than this (0 = keep forever)
+	FlagStatistics             bool              `mapstructure:"flag_statistics"`                 // Flag numeric claims in generated content for review
+	PublishParallelism         int               `mapstructure:"publish_parallelism"`             // Scheduled posts published concurrently (1 = sequential)
+	GlobalTopicCooldownHours   int               `mapstructure:"global_topic_cooldown_hours"`     // Min hours before the same topic can be posted again (shared storage)
+	MaxRetryPublishesPerDay    int               `mapstructure:"max_retry_publishes_per_day"`     // Cap on retried publishes per day (0 = no cap)
+	RetryBackoffMinutes        int               `mapstructure:"retry_backoff_minutes"`           // Wait before auto-retrying a failed post, doubled per attempt
+	RetryMaxAgeHours           int               `mapstructure:"retry_max_age_hours"`             // Stop auto-retrying posts scheduled longer ago than this (0 = no cap)
+	Templates                  map[string]string `mapstructure:"templates"`                       // Named post skeletons with {placeholders} for --template
+	Voices                     map[string]string `mapstructure:"voices"`                          // Named brand voice profiles for --voice (default = brand_voice)
+	AvoidRecentHooks           int               `mapstructure:"avoid_recent_hooks"`              // Tell the AI not to reuse openers of the last N hooks (0 = off)
+	MaxTopicsPerSourceInDigest int               `mapstructure:"max_topics_per_source_in_digest"` // Max digest stories from one source (0 = no cap)
+	NearDuplicateThreshold     float64           `mapstructure:"near_duplicate_threshold"`        // Word-overlap (Jaccard) at which a new draft is flagged as a near-duplicate (0 = off)
+	Author                     AuthorConfig      `mapstructure:"author"`                          // Identity used in post headers and footers
+	TargetWordCount            int               `mapstructure:"target_word_count"`               // Approximate length of generated single-topic posts
+	MaxCharacters              int               `mapstructure:"max_characters"`                  // Character budget for posts; longer commentary is truncated (max 3000)
+	PollDurationDays           int               `mapstructure:"poll_duration_days"`              // How long generated polls stay open: 1, 3, 7 or 14 days
+	FreshnessHalfLifeHours     float64           `mapstructure:"freshness_half_life_hours"`       // Topic age at which its digest score counts half (0 = no decay)
+}
+
+// AuthorConfig holds the identity shown in post headers and footers.
+// When no name, template or link is set, the original author's identity is used.
+type AuthorConfig struct {
+	DisplayName    string `mapstructure:"display_name"`    // Name used in headers, e.g. "Morning Updates from <name>"
+	HeaderTemplate string `mapstructure:"header_template"` // First line of single-topic posts; {name} is replaced with display_name
+	LinkedInURL    string `mapstructure:"linkedin_url"`    // Profile link in the footer (empty = omit)
+	InstagramURL   string `mapstructure:"instagram_url"`   // Profile link in the footer (empty = omit)
+	EnableFooter   bool   `mapstructure:"enable_footer"`   // Append the profile links footer to posts
+}
+
+// IsEmpty reports whether no part of the author identity has been configured
+func (a AuthorConfig) IsEmpty() bool {
+	return a.DisplayName == "" && a.HeaderTemplate == "" && a.LinkedInURL == "" && a.InstagramURL == ""
 }
 
 // TrackerConfig holds Google Sheets tracker settings
@@ -147,31 +271,55 @@ type TrackerConfig struct {
 
 // MediaConfig holds image/media settings
 type MediaConfig struct {
-	Enabled        bool   `mapstructure:"enabled"`
-	Provider       string `mapstructure:"provider"`         // "unsplash" or "none"
-	UnsplashAPIKey string `mapstructure:"unsplash_api_key"` // Unsplash API access key
-	FallbackToText bool   `mapstructure:"fallback_to_text"` // If image fails, post text-only
+	Enabled         bool    `mapstructure:"enabled"`
+	Provider        string  `mapstructure:"provider"`            // "unsplash", "local" or "none"
+	UnsplashAPIKey  string  `mapstructure:"unsplash_api_key"`    // Unsplash API access key
+	UnsplashBaseURL string  `mapstructure:"unsplash_base_url"`   // API host override (empty = api.unsplash.com)
+	FallbackToText  bool    `mapstructure:"fallback_to_text"`    // If image fails, post text-only
+	MinWidth        int     `mapstructure:"min_width"`           // Skip photos narrower than this in pixels (0 = any)
+	Orientation     string  `mapstructure:"orientation"`         // landscape, portrait or squarish (empty = landscape)
+	MinRelevance    float64 `mapstructure:"relevance_threshold"` // Min share of search words in the photo description, 0-1 (0 = off)
+	LocalDir        string  `mapstructure:"local_dir"`           // Directory of images for the "local" provider
+	LocalSelection  string  `mapstructure:"local_selection"`     // random or round_robin
 }
 
 // CommenterConfig holds auto-comment settings
 type CommenterConfig struct {
-	Enabled           bool     `mapstructure:"enabled"`
-	MaxCommentsPerDay int      `mapstructure:"max_comments_per_day"` // Limit to avoid spam detection
-	TargetInfluencers []string `mapstructure:"target_influencers"`   // List of person URNs to monitor
-	TargetKeywords    []string `mapstructure:"target_keywords"`      // Keywords to search for posts
-	MinPostEngagement int      `mapstructure:"min_post_engagement"`  // Min likes/reactions to comment
-	MaxPostEngagement int      `mapstructure:"max_post_engagement"`  // Max engagement (skip mega-viral)
-	CommentStyle      string   `mapstructure:"comment_style"`        // insightful, question, supportive
+	Enabled            bool     `mapstructure:"enabled"`
+	MaxCommentsPerDay  int      `mapstructure:"max_comments_per_day"` // Limit to avoid spam detection
+	TargetInfluencers  []string `mapstructure:"target_influencers"`   // List of person URNs to monitor
+	PreferKeywords     []string `mapstructure:"prefer_keywords"`      // Rank posts mentioning these (whole word) first; does not discover new posts
+	BlockedAuthors     []string `mapstructure:"blocked_authors"`      // Authors (URNs or vanity names) never to comment on
+	BlockedKeywords    []string `mapstructure:"blocked_keywords"`     // Never comment on posts containing these (case-insensitive)
+	PreferConnections  bool     `mapstructure:"prefer_connections"`   // Prioritize posts from your own connections
+	QuoteTargetContent bool     `mapstructure:"quote_target_content"` // Anchor comments in a verified quote from the post
+	ResolveAuthorNames bool     `mapstructure:"resolve_author_names"` // Look up (and cache) target authors' display names
+	MinPostEngagement  int      `mapstructure:"min_post_engagement"`  // Min likes/reactions to comment
+	MaxPostEngagement  int      `mapstructure:"max_post_engagement"`  // Max engagement (skip mega-viral)
+	CommentStyle       string   `mapstructure:"comment_style"`        // insightful, question, supportive
 	// Timing controls to avoid spam detection
-	MinIntervalMinutes int      `mapstructure:"min_interval_minutes"` // Min minutes between comments
-	MaxIntervalMinutes int      `mapstructure:"max_interval_minutes"` // Max minutes for randomization
-	ActiveHoursStart   int      `mapstructure:"active_hours_start"`   // Start hour (0-23)
-	ActiveHoursEnd     int      `mapstructure:"active_hours_end"`     // End hour (0-23)
-	MaxPostAgeHours    int      `mapstructure:"max_post_age_hours"`   // Skip posts older than this
-	MinPostAgeMinutes  int      `mapstructure:"min_post_age_minutes"` // Skip very new posts
+	MinIntervalMinutes int `mapstructure:"min_interval_minutes"` // Min minutes between comments
+	MaxIntervalMinutes int `mapstructure:"max_interval_minutes"` // Max minutes for randomization
+	ActiveHoursStart   int `mapstructure:"active_hours_start"`   // Start hour (0-23)
+	ActiveHoursEnd     int `mapstructure:"active_hours_end"`     // End hour (0-23)
+	MaxPostAgeHours    int `mapstructure:"max_post_age_hours"`   // Skip posts older than this
+	MinPostAgeMinutes  int `mapstructure:"min_post_age_minutes"` // Skip very new posts
 	// Style rotation
 	CommentStyleRotation bool     `mapstructure:"comment_style_rotation"` // Rotate between styles
 	CommentStyles        []string `mapstructure:"comment_styles"`         // Available styles to rotate
+	// Quality control
+	SelfReview    bool     `mapstructure:"self_review"`    // Second AI pass to score and rewrite generic comments
+	DryRun        bool     `mapstructure:"dry_run"`        // Generate and save comments as pending without posting them
+	MinQuality    float64  `mapstructure:"min_quality"`    // Skip comments the self-review scores below this instead of rewriting them (0-100, 0 = off)
+	BannedPhrases []string `mapstructure:"banned_phrases"` // Skip comments containing any of these (case-insensitive)
+	AllowLinks    bool     `mapstructure:"allow_links"`    // Allow URLs in comments (off = treat links as promotional and skip)
+	// IANA time zone for active hours (empty = scheduler.timezone)
+	Timezone string `mapstructure:"timezone"`
+}
+
+// Location returns the time zone active hours are interpreted in
+func (c CommenterConfig) Location() *time.Location {
+	return loadLocation(c.Timezone)
 }
 
 // Load loads configuration from file and environment variables
@@ -222,6 +370,10 @@ func Load(configPath string) (*Config, error) {
 	v.BindEnv("publishing.min_score_threshold", "LINKEDIN_PUBLISHING_MIN_SCORE_THRESHOLD")
 	v.BindEnv("media.enabled", "LINKEDIN_MEDIA_ENABLED")
 	v.BindEnv("media.unsplash_api_key", "LINKEDIN_MEDIA_UNSPLASH_API_KEY")
+	v.BindEnv("sources.newsapi.api_key", "LINKEDIN_SOURCES_NEWSAPI_API_KEY")
+	v.BindEnv("sources.reddit.client_id", "LINKEDIN_SOURCES_REDDIT_CLIENT_ID")
+	v.BindEnv("sources.reddit.client_secret", "LINKEDIN_SOURCES_REDDIT_CLIENT_SECRET")
+	v.BindEnv("sources.twitter.bearer_token", "LINKEDIN_SOURCES_TWITTER_BEARER_TOKEN")
 
 	// Read config file (ignore if not found)
 	if err := v.ReadInConfig(); err != nil {
@@ -235,6 +387,34 @@ func Load(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("error unmarshaling config: %w", err)
 	}
 
+	if config.Commenter.Timezone == "" {
+		config.Commenter.Timezone = config.Scheduler.Timezone
+	}
+	if v.IsSet("commenter.target_keywords") {
+		// Keyword discovery is not possible: LinkedIn has no post search API
+		return nil, fmt.Errorf("commenter.target_keywords is not supported: LinkedIn has no post search API, so keywords cannot discover posts; use commenter.prefer_keywords to rank posts from connections and influencers")
+	}
+	for key, name := range map[string]string{
+		"scheduler.timezone": config.Scheduler.Timezone,
+		"commenter.timezone": config.Commenter.Timezone,
+	} {
+		if _, err := time.LoadLocation(name); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", key, name, err)
+		}
+	}
+	if config.Anthropic.RankBatchSize < 1 {
+		return nil, fmt.Errorf("invalid anthropic.rank_batch_size %d: must be at least 1", config.Anthropic.RankBatchSize)
+	}
+	if config.Sources.MaxConcurrency < 0 {
+		return nil, fmt.Errorf("invalid sources.max_concurrency %d: must be 0 (unlimited) or more", config.Sources.MaxConcurrency)
+	}
+	if d, err := time.ParseDuration(config.Sources.FetchTimeout); err != nil || d < 0 {
+		return nil, fmt.Errorf("invalid sources.fetch_timeout %q: must be a duration like 2m (0 = no limit)", config.Sources.FetchTimeout)
+	}
+	if d, err := time.ParseDuration(config.Sources.RSS.MaxAge); err != nil || d <= 0 {
+		return nil, fmt.Errorf("invalid sources.rss.max_age %q: must be a positive duration like 24h", config.Sources.RSS.MaxAge)
+	}
+
 	return &config, nil
 }
 
@@ -247,13 +427,29 @@ func setDefaults(v *viper.Viper) {
 	// LinkedIn defaults
 	v.SetDefault("linkedin.redirect_uri", "http://localhost:8080/callback")
 	v.SetDefault("linkedin.scopes", []string{"w_member_social", "r_liteprofile"})
+	v.SetDefault("linkedin.expiry_warning_days", 7)
+	v.SetDefault("linkedin.api_base_url", "")
 
 	// Anthropic defaults
 	v.SetDefault("anthropic.model", "claude-sonnet-4-20250514")
 	v.SetDefault("anthropic.max_tokens", 4096)
 	v.SetDefault("anthropic.temperature", 0.7)
+	v.SetDefault("anthropic.ranking_temperature", 0.2)
+	v.SetDefault("anthropic.content_temperature", 0.8)
+	v.SetDefault("anthropic.ranking_model", "")
+	v.SetDefault("anthropic.content_model", "")
+	v.SetDefault("anthropic.rank_batch_size", 10)
+	v.SetDefault("anthropic.retry_invalid_json", true)
+	v.SetDefault("anthropic.base_url", "")
+	v.SetDefault("anthropic.save_raw_responses", false)
+	v.SetDefault("anthropic.input_price_per_mtok", 3.0)
+	v.SetDefault("anthropic.output_price_per_mtok", 15.0)
+	v.SetDefault("anthropic.max_requests_per_day", 0)
+	v.SetDefault("anthropic.max_tokens_per_day", 0)
 
 	// Sources defaults
+	v.SetDefault("sources.max_concurrency", 4)
+	v.SetDefault("sources.fetch_timeout", "2m")
 	v.SetDefault("sources.newsapi.enabled", true)
 	v.SetDefault("sources.newsapi.language", "en")
 	v.SetDefault("sources.newsapi.categories", []string{"business", "technology"})
@@ -261,6 +457,8 @@ func setDefaults(v *viper.Viper) {
 
 	v.SetDefault("sources.rss.enabled", true)
 	v.SetDefault("sources.rss.fetch_interval", "30m")
+	v.SetDefault("sources.rss.max_age", "168h")
+	v.SetDefault("sources.rss.fetch_full_text", false)
 
 	v.SetDefault("sources.twitter.enabled", false)
 	v.SetDefault("sources.twitter.fetch_interval", "1h")
@@ -268,8 +466,18 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("sources.reddit.enabled", true)
 	v.SetDefault("sources.reddit.fetch_interval", "1h")
 
+	v.SetDefault("sources.hackernews.enabled", false)
+	v.SetDefault("sources.hackernews.feed", "top")
+	v.SetDefault("sources.hackernews.max_stories", 30)
+	v.SetDefault("sources.hackernews.min_score", 100)
+
 	v.SetDefault("sources.custom.enabled", true)
 
+	// Discovery defaults
+	v.SetDefault("discovery.allowed_domains", []string{})
+	v.SetDefault("discovery.blocked_domains", []string{})
+	v.SetDefault("discovery.title_similarity_threshold", 0)
+
 	// Scheduler defaults
 	v.SetDefault("scheduler.discovery_cron", "0 */2 * * *") // Every 2 hours
 	v.SetDefault("scheduler.digest_cron", "55 7 * * *")     // 7:55am daily - generate digest before publish
@@ -280,11 +488,19 @@ func setDefaults(v *viper.Viper) {
 		"0 17 * * *", // 5:00 PM - end of workday
 	})
 	v.SetDefault("scheduler.cleanup_cron", "0 0 * * 0") // Weekly cleanup
+	v.SetDefault("scheduler.cleanup_fix_orphans", false)
+	v.SetDefault("scheduler.cleanup_max_age_days", 0)
+	v.SetDefault("scheduler.weekly_recap_cron", "")
+	v.SetDefault("scheduler.publish_on_start", false)
+	v.SetDefault("scheduler.poll_results_cron", "")
+	v.SetDefault("scheduler.analytics_cron", "")
+	v.SetDefault("scheduler.notify_webhook_url", "")
 
 	// Rate limit defaults
 	v.SetDefault("rate_limit.linkedin_requests_per_day", 100)
 	v.SetDefault("rate_limit.anthropic_requests_per_minute", 10)
 	v.SetDefault("rate_limit.source_requests_per_hour", 60)
+	v.SetDefault("rate_limit.linkedin_max_retries", 3)
 
 	// Logging defaults
 	v.SetDefault("logging.level", "info")
@@ -297,6 +513,22 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("publishing.min_score_threshold", 70.0)
 	v.SetDefault("publishing.default_post_type", "text")
 	v.SetDefault("publishing.brand_voice", "Professional, insightful, and engaging. Focus on actionable insights for business leaders.")
+	v.SetDefault("publishing.hook_fold_length", 210)
+	v.SetDefault("publishing.max_draft_age_days", 0)
+	v.SetDefault("publishing.flag_statistics", false)
+	v.SetDefault("publishing.publish_parallelism", 1)
+	v.SetDefault("publishing.global_topic_cooldown_hours", 0)
+	v.SetDefault("publishing.max_retry_publishes_per_day", 0)
+	v.SetDefault("publishing.retry_backoff_minutes", 0)
+	v.SetDefault("publishing.retry_max_age_hours", 0)
+	v.SetDefault("publishing.avoid_recent_hooks", 0)
+	v.SetDefault("publishing.max_topics_per_source_in_digest", 0)
+	v.SetDefault("publishing.near_duplicate_threshold", 0)
+	v.SetDefault("publishing.author.enable_footer", true)
+	v.SetDefault("publishing.target_word_count", 275)
+	v.SetDefault("publishing.max_characters", 3000)
+	v.SetDefault("publishing.poll_duration_days", 3)
+	v.SetDefault("publishing.freshness_half_life_hours", 0)
 
 	// Tracker defaults
 	v.SetDefault("tracker.enabled", false)
@@ -305,7 +537,13 @@ func setDefaults(v *viper.Viper) {
 	// Media defaults
 	v.SetDefault("media.enabled", false)
 	v.SetDefault("media.provider", "unsplash")
+	v.SetDefault("media.unsplash_base_url", "")
 	v.SetDefault("media.fallback_to_text", true)
+	v.SetDefault("media.min_width", 0)
+	v.SetDefault("media.orientation", "")
+	v.SetDefault("media.relevance_threshold", 0.0)
+	v.SetDefault("media.local_dir", "")
+	v.SetDefault("media.local_selection", "random")
 
 	// Commenter defaults
 	v.SetDefault("commenter.enabled", false)
@@ -313,6 +551,9 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("commenter.min_post_engagement", 50)
 	v.SetDefault("commenter.max_post_engagement", 5000)
 	v.SetDefault("commenter.comment_style", "insightful")
+	v.SetDefault("commenter.prefer_connections", false)
+	v.SetDefault("commenter.quote_target_content", false)
+	v.SetDefault("commenter.resolve_author_names", true)
 	// Timing defaults - conservative to avoid spam detection
 	v.SetDefault("commenter.min_interval_minutes", 45)
 	v.SetDefault("commenter.max_interval_minutes", 90)
@@ -323,6 +564,12 @@ func setDefaults(v *viper.Viper) {
 	// Style rotation
 	v.SetDefault("commenter.comment_style_rotation", true)
 	v.SetDefault("commenter.comment_styles", []string{"insightful", "question", "supportive"})
+	// Quality control
+	v.SetDefault("commenter.self_review", false)
+	v.SetDefault("commenter.dry_run", false)
+	v.SetDefault("commenter.min_quality", 0)
+	v.SetDefault("commenter.banned_phrases", []string{})
+	v.SetDefault("commenter.allow_links", true)
 }
 
 // Validate validates the configuration
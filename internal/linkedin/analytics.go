@@ -0,0 +1,132 @@
+package linkedin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// socialActionsResponse represents the like/comment summary of a post
+type socialActionsResponse struct {
+	LikesSummary struct {
+		TotalLikes int `json:"totalLikes"`
+	} `json:"likesSummary"`
+	CommentsSummary struct {
+		AggregatedTotalComments int `json:"aggregatedTotalComments"`
+	} `json:"commentsSummary"`
+}
+
+// shareStatisticsResponse represents the organizationalEntityShareStatistics response
+type shareStatisticsResponse struct {
+	Elements []struct {
+		TotalShareStatistics struct {
+			ImpressionCount int `json:"impressionCount"`
+			ShareCount      int `json:"shareCount"`
+			LikeCount       int `json:"likeCount"`
+			CommentCount    int `json:"commentCount"`
+		} `json:"totalShareStatistics"`
+	} `json:"elements"`
+}
+
+// GetPostStats fetches the engagement of a published post. Likes and comments come from
+// socialActions; shares and impressions are only reported by LinkedIn for organization
+// posts and are 0 for posts authored by a member.
+func (c *Client) GetPostStats(ctx context.Context, postURN string) (likes, comments, shares, impressions int, err error) {
+	resp, err := c.do(ctx, "GET", "/socialActions/"+url.PathEscape(postURN), nil)
+	if err != nil {
+		return 0, 0, 0, 0, fmt.Errorf("failed to fetch social actions: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, 0, 0, fmt.Errorf("failed to fetch social actions: %s - %s", resp.Status, string(body))
+	}
+
+	var actions socialActionsResponse
+	if err := json.Unmarshal(body, &actions); err != nil {
+		return 0, 0, 0, 0, fmt.Errorf("failed to parse social actions response: %w", err)
+	}
+	likes = actions.LikesSummary.TotalLikes
+	comments = actions.CommentsSummary.AggregatedTotalComments
+
+	author, err := c.GetPostAuthor(ctx, postURN)
+	if err != nil {
+		c.log.Warn().Err(err).Str("post_urn", postURN).Msg("Failed to look up post author, skipping share statistics")
+		return likes, comments, 0, 0, nil
+	}
+	if !strings.HasPrefix(author, "urn:li:organization:") {
+		c.log.Debug().Str("post_urn", postURN).Msg("Share statistics are only available for organization posts")
+		return likes, comments, 0, 0, nil
+	}
+
+	shares, impressions, err = c.shareStatistics(ctx, author, postURN)
+	if err != nil {
+		c.log.Warn().Err(err).Str("post_urn", postURN).Msg("Failed to fetch share statistics")
+		return likes, comments, 0, 0, nil
+	}
+
+	c.log.Debug().
+		Str("post_urn", postURN).
+		Int("likes", likes).
+		Int("comments", comments).
+		Int("shares", shares).
+		Int("impressions", impressions).
+		Msg("Fetched post stats")
+
+	return likes, comments, shares, impressions, nil
+}
+
+// GetPostAuthor returns the author URN of a post
+func (c *Client) GetPostAuthor(ctx context.Context, postURN string) (string, error) {
+	body, err := c.getPost(ctx, postURN)
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		Author string `json:"author"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("failed to parse post response: %w", err)
+	}
+	return result.Author, nil
+}
+
+// shareStatistics fetches the lifetime share and impression counts of an organization post
+func (c *Client) shareStatistics(ctx context.Context, organizationURN, postURN string) (shares, impressions int, err error) {
+	param := "shares"
+	if strings.HasPrefix(postURN, "urn:li:ugcPost:") {
+		param = "ugcPosts"
+	}
+	endpoint := fmt.Sprintf("/organizationalEntityShareStatistics?q=organizationalEntity&organizationalEntity=%s&%s=List(%s)",
+		url.QueryEscape(organizationURN), param, url.QueryEscape(postURN))
+
+	resp, err := c.doREST(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("share statistics request failed: %s - %s", resp.Status, string(body))
+	}
+
+	var result shareStatisticsResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse share statistics response: %w", err)
+	}
+	if len(result.Elements) == 0 {
+		return 0, 0, nil
+	}
+
+	totals := result.Elements[0].TotalShareStatistics
+	return totals.ShareCount, totals.ImpressionCount, nil
+}
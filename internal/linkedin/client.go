@@ -7,10 +7,14 @@ import (
 	"fmt"
 	"html"
 	"io"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/linkedin-agent/internal/models"
 	"github.com/linkedin-agent/pkg/logger"
@@ -18,10 +22,11 @@ import (
 )
 
 const (
-	baseURL         = "https://api.linkedin.com/v2"
-	restBaseURL     = "https://api.linkedin.com/rest" // For newer REST APIs (Images, etc.)
-	restliVersion   = "2.0.0"
-	linkedinVersion = "202601" // LinkedIn API version (YYYYMM format)
+	defaultBaseURL     = "https://api.linkedin.com/v2"
+	defaultRESTBaseURL = "https://api.linkedin.com/rest" // For newer REST APIs (Images, etc.)
+	restliVersion      = "2.0.0"
+	linkedinVersion    = "202601" // LinkedIn API version (YYYYMM format)
+	defaultMaxRetries  = 3
 )
 
 // Client handles LinkedIn API requests
@@ -30,12 +35,53 @@ type Client struct {
 	oauthManager *OAuthManager
 	rateLimiter  *ratelimit.MultiLimiter
 	log          *logger.Logger
-	urnCache     map[string]string // Cache for username -> URN mappings
+	urnCache     map[string]string         // Cache for username -> URN mappings
+	profileCache map[string]*MemberProfile // Cache for person URN -> profile (nil = lookup failed)
+	baseURL      string
+	restBaseURL  string
+	maxRetries   int // Retries on 429/5xx responses
+	maxChars     int // Post commentary is truncated beyond this length
+}
+
+// ClientOption configures a Client
+type ClientOption func(*Client)
+
+// WithBaseURL points the client at a different API host (e.g. a proxy or mock server).
+// The v2 and REST APIs are served from the "/v2" and "/rest" paths under it.
+// An empty URL keeps the default.
+func WithBaseURL(apiURL string) ClientOption {
+	return func(c *Client) {
+		if apiURL == "" {
+			return
+		}
+		apiURL = strings.TrimSuffix(apiURL, "/")
+		c.SetBaseURLs(apiURL+"/v2", apiURL+"/rest")
+	}
+}
+
+// WithMaxRetries sets how many times a request is retried on 429 responses and, for reads, 5xx responses
+// (0 disables retries). Negative values keep the default.
+func WithMaxRetries(n int) ClientOption {
+	return func(c *Client) {
+		if n >= 0 {
+			c.maxRetries = n
+		}
+	}
+}
+
+// WithMaxCharacters lowers the length at which post commentary is truncated.
+// Values that are too small to truncate into or above LinkedIn's limit keep the limit.
+func WithMaxCharacters(n int) ClientOption {
+	return func(c *Client) {
+		if n > 3 && n < maxCommentaryLength {
+			c.maxChars = n
+		}
+	}
 }
 
 // NewClient creates a new LinkedIn API client
-func NewClient(oauth *OAuthManager, limiter *ratelimit.MultiLimiter, log *logger.Logger) *Client {
-	return &Client{
+func NewClient(oauth *OAuthManager, limiter *ratelimit.MultiLimiter, log *logger.Logger, opts ...ClientOption) *Client {
+	c := &Client{
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
@@ -43,16 +89,31 @@ func NewClient(oauth *OAuthManager, limiter *ratelimit.MultiLimiter, log *logger
 		rateLimiter:  limiter,
 		log:          log.WithComponent("linkedin"),
 		urnCache:     make(map[string]string),
+		profileCache: make(map[string]*MemberProfile),
+		baseURL:      defaultBaseURL,
+		restBaseURL:  defaultRESTBaseURL,
+		maxRetries:   defaultMaxRetries,
+		maxChars:     maxCommentaryLength,
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
 
-// do performs an HTTP request with proper authentication and headers
-func (c *Client) do(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
-	// Wait for rate limiter
-	if err := c.rateLimiter.Wait(ctx, ratelimit.LimiterLinkedIn); err != nil {
-		return nil, fmt.Errorf("rate limit error: %w", err)
+// SetBaseURLs overrides the v2 and REST API base URLs, e.g. to target a mock server.
+// Empty values keep the current URL.
+func (c *Client) SetBaseURLs(v2URL, restURL string) {
+	if v2URL != "" {
+		c.baseURL = strings.TrimSuffix(v2URL, "/")
+	}
+	if restURL != "" {
+		c.restBaseURL = strings.TrimSuffix(restURL, "/")
 	}
+}
 
+// do performs an HTTP request with proper authentication and headers
+func (c *Client) do(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
 	// Get valid token
 	token, err := c.oauthManager.GetValidToken(ctx)
 	if err != nil {
@@ -78,7 +139,7 @@ func (c *Client) do(ctx context.Context, method, path string, body interface{})
 	}
 
 	// Create request
-	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -94,9 +155,9 @@ func (c *Client) do(ctx context.Context, method, path string, body interface{})
 		Str("path", path).
 		Msg("Making LinkedIn API request")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, err
 	}
 
 	// Log response status
@@ -107,13 +168,97 @@ func (c *Client) do(ctx context.Context, method, path string, body interface{})
 	return resp, nil
 }
 
-// doREST performs an HTTP request to the REST API (for newer endpoints like Images API)
-func (c *Client) doREST(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
-	// Wait for rate limiter
-	if err := c.rateLimiter.Wait(ctx, ratelimit.LimiterLinkedIn); err != nil {
-		return nil, fmt.Errorf("rate limit error: %w", err)
+// Retry backoff bounds for 429/5xx responses
+const (
+	retryBaseDelay = time.Second
+	retryMaxDelay  = 2 * time.Minute
+)
+
+// send executes the request, retrying up to maxRetries times on 429 and 5xx responses.
+// Delays honor Retry-After when present and otherwise back off exponentially with jitter.
+// Every attempt waits on the rate limiter. Writes are not retried on a bare 5xx, since it
+// may come after the post or comment was already created.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := c.rateLimiter.Wait(ctx, ratelimit.LimiterLinkedIn); err != nil {
+			return nil, fmt.Errorf("rate limit error: %w", err)
+		}
+
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to reset request body: %w", err)
+			}
+			req.Body = body
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("request failed: %w", err)
+		}
+
+		if !retryable(req.Method, resp) || attempt >= c.maxRetries {
+			return resp, nil
+		}
+
+		delay := retryDelay(resp.Header.Get("Retry-After"), attempt)
+		resp.Body.Close()
+
+		c.log.Warn().
+			Int("status", resp.StatusCode).
+			Str("path", req.URL.Path).
+			Int("attempt", attempt+1).
+			Dur("delay", delay).
+			Msg("LinkedIn API request failed, retrying")
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// retryable reports whether a failed response may be retried. Reads are retried on 429 and
+// 5xx; writes only when LinkedIn asked us to come back later, so a retry can't publish the
+// same content twice.
+func retryable(method string, resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
 	}
+	if resp.StatusCode < 500 {
+		return false
+	}
+	if method == http.MethodGet {
+		return true
+	}
+	return resp.Header.Get("Retry-After") != ""
+}
 
+// retryDelay returns how long to wait before retry number attempt+1: the Retry-After
+// value (seconds or HTTP date) when given, else exponential backoff with up to 50% jitter
+func retryDelay(retryAfter string, attempt int) time.Duration {
+	var delay time.Duration
+	if secs, err := strconv.Atoi(retryAfter); err == nil && secs >= 0 {
+		delay = time.Duration(secs) * time.Second
+	} else if at, err := http.ParseTime(retryAfter); err == nil {
+		delay = time.Until(at)
+	} else {
+		delay = retryBaseDelay << attempt
+		delay += time.Duration(rand.Int63n(int64(delay)/2 + 1))
+	}
+
+	if delay < 0 {
+		delay = 0
+	}
+	if delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	return delay
+}
+
+// doREST performs an HTTP request to the REST API (for newer endpoints like Images API)
+func (c *Client) doREST(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
 	// Get valid token
 	token, err := c.oauthManager.GetValidToken(ctx)
 	if err != nil {
@@ -134,7 +279,7 @@ func (c *Client) doREST(ctx context.Context, method, path string, body interface
 	}
 
 	// Create request using REST base URL
-	req, err := http.NewRequestWithContext(ctx, method, restBaseURL+path, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, c.restBaseURL+path, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -147,12 +292,12 @@ func (c *Client) doREST(ctx context.Context, method, path string, body interface
 
 	c.log.Debug().
 		Str("method", method).
-		Str("url", restBaseURL+path).
+		Str("url", c.restBaseURL+path).
 		Msg("Making LinkedIn REST API request")
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.send(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, err
 	}
 
 	c.log.Debug().
@@ -194,37 +339,73 @@ type Profile struct {
 	EmailVerified bool   `json:"email_verified"`
 }
 
-// ResolveToURN converts a LinkedIn identifier (username or URN) to a URN format.
-// If the input is already a URN (starts with "urn:li:"), it returns as-is.
-// If it's a username, it attempts to resolve it to a URN via the API.
+// ResolveToURN converts a LinkedIn identifier to a URN. Supported formats:
+//   - a URN ("urn:li:person:abc123", "urn:li:organization:123"), returned as-is
+//   - a vanity name ("satyanadella") or "@satyanadella"
+//   - a profile URL ("https://www.linkedin.com/in/satyanadella/")
+//
+// Vanity names are looked up with the /people API, which needs profile permissions many
+// apps don't have; when the lookup fails an error is returned and the member URN must be
+// configured instead. Results, including failures, are cached per process.
 func (c *Client) ResolveToURN(ctx context.Context, identifier string) (string, error) {
+	identifier = strings.TrimSpace(identifier)
+
 	// If already a URN, return as-is
 	if strings.HasPrefix(identifier, "urn:li:") {
 		return identifier, nil
 	}
 
-	// Check cache first
-	if urn, ok := c.urnCache[identifier]; ok {
+	vanityName, err := vanityNameFromIdentifier(identifier)
+	if err != nil {
+		return "", err
+	}
+
+	// Check cache first (an empty URN records a failed lookup)
+	if urn, ok := c.urnCache[vanityName]; ok {
+		if urn == "" {
+			return "", fmt.Errorf("could not resolve %q to a URN (configure its urn:li:person:<id> instead)", identifier)
+		}
 		return urn, nil
 	}
 
-	// Try to resolve username to URN via LinkedIn API
 	// LinkedIn's /people endpoint can look up by vanity name
-	urn, err := c.lookupUserByVanityName(ctx, identifier)
+	urn, err := c.lookupUserByVanityName(ctx, vanityName)
 	if err != nil {
-		c.log.Warn().
-			Err(err).
-			Str("identifier", identifier).
-			Msg("Failed to resolve username to URN, using as person URN directly")
-		// Fallback: assume it might be a raw member ID
-		urn = fmt.Sprintf("urn:li:person:%s", identifier)
+		c.urnCache[vanityName] = ""
+		return "", fmt.Errorf("could not resolve %q to a URN (configure its urn:li:person:<id> instead): %w", identifier, err)
 	}
 
 	// Cache the result
-	c.urnCache[identifier] = urn
+	c.urnCache[vanityName] = urn
 	return urn, nil
 }
 
+// vanityNameFromIdentifier extracts the vanity name from a vanity name, "@name" or a
+// linkedin.com/in/ profile URL
+func vanityNameFromIdentifier(identifier string) (string, error) {
+	if strings.Contains(identifier, "linkedin.com/") {
+		u, err := url.Parse(identifier)
+		if err != nil || u.Host == "" {
+			u, err = url.Parse("https://" + identifier)
+		}
+		if err != nil {
+			return "", fmt.Errorf("invalid LinkedIn URL %q: %w", identifier, err)
+		}
+
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(parts) < 2 || parts[0] != "in" || parts[1] == "" {
+			return "", fmt.Errorf("unsupported LinkedIn URL %q (expected a linkedin.com/in/<name> profile URL)", identifier)
+		}
+		identifier = parts[1]
+	}
+
+	identifier = strings.TrimPrefix(identifier, "@")
+	if identifier == "" || strings.ContainsAny(identifier, " /:") {
+		return "", fmt.Errorf("invalid LinkedIn identifier %q", identifier)
+	}
+	return identifier, nil
+}
+
 // lookupUserByVanityName attempts to get a user's URN from their LinkedIn vanity URL name
 func (c *Client) lookupUserByVanityName(ctx context.Context, vanityName string) (string, error) {
 	// LinkedIn API endpoint for profile lookup by vanity name
@@ -269,7 +450,7 @@ func (c *Client) lookupUserByVanityName(ctx context.Context, vanityName string)
 }
 
 // ResolveMultipleToURNs resolves multiple identifiers to URNs
-// Returns a map of original identifier -> resolved URN
+// Returns a map of original identifier -> resolved URN (unresolved identifiers are left out)
 func (c *Client) ResolveMultipleToURNs(ctx context.Context, identifiers []string) (map[string]string, []error) {
 	results := make(map[string]string)
 	var errors []error
@@ -278,8 +459,7 @@ func (c *Client) ResolveMultipleToURNs(ctx context.Context, identifiers []string
 		urn, err := c.ResolveToURN(ctx, id)
 		if err != nil {
 			errors = append(errors, fmt.Errorf("failed to resolve %s: %w", id, err))
-			// Still store the fallback URN
-			urn = fmt.Sprintf("urn:li:person:%s", id)
+			continue
 		}
 		results[id] = urn
 	}
@@ -287,9 +467,121 @@ func (c *Client) ResolveMultipleToURNs(ctx context.Context, identifiers []string
 	return results, errors
 }
 
+// MemberProfile is the public profile of another LinkedIn member
+type MemberProfile struct {
+	URN       string `json:"-"`
+	FirstName string `json:"localizedFirstName"`
+	LastName  string `json:"localizedLastName"`
+}
+
+// Name returns the member's display name
+func (p *MemberProfile) Name() string {
+	return strings.TrimSpace(p.FirstName + " " + p.LastName)
+}
+
+// GetPersonName returns the display name of a member, using the cached profile lookup
+func (c *Client) GetPersonName(ctx context.Context, personURN string) (string, error) {
+	profile, err := c.GetProfileByURN(ctx, personURN)
+	if err != nil {
+		return "", err
+	}
+	if profile.Name() == "" {
+		return "", fmt.Errorf("profile %s has no name", personURN)
+	}
+	return profile.Name(), nil
+}
+
+// GetProfileByURN looks up a member's profile by person URN. Results are cached, including
+// failed lookups, so each author costs at most one API call per process.
+// Note: This requires profile API permissions that may not be available to all apps.
+func (c *Client) GetProfileByURN(ctx context.Context, personURN string) (*MemberProfile, error) {
+	if profile, ok := c.profileCache[personURN]; ok {
+		if profile == nil {
+			return nil, fmt.Errorf("profile %s unavailable", personURN)
+		}
+		return profile, nil
+	}
+
+	memberID := strings.TrimPrefix(personURN, "urn:li:person:")
+	if memberID == personURN {
+		return nil, fmt.Errorf("not a person URN: %s", personURN)
+	}
+
+	endpoint := fmt.Sprintf("/people/(id:%s)", memberID)
+
+	resp, err := c.do(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch profile: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		c.profileCache[personURN] = nil
+		return nil, fmt.Errorf("profile lookup failed: %s - %s", resp.Status, string(body))
+	}
+
+	var profile MemberProfile
+	if err := json.Unmarshal(body, &profile); err != nil {
+		return nil, fmt.Errorf("failed to parse profile response: %w", err)
+	}
+	profile.URN = personURN
+
+	c.profileCache[personURN] = &profile
+
+	c.log.Debug().
+		Str("urn", personURN).
+		Str("name", profile.Name()).
+		Msg("Fetched member profile")
+
+	return &profile, nil
+}
+
 // LinkedIn content limits
 const maxCommentaryLength = 3000
 
+// LinkedIn poll limits
+const (
+	MinPollOptions      = 2
+	MaxPollOptions      = 4
+	MaxPollOptionLength = 30
+)
+
+// NormalizePollOptions makes poll options acceptable to LinkedIn: blank options are dropped,
+// the list is trimmed to MaxPollOptions and each option is truncated to MaxPollOptionLength
+// characters. Returns an error when fewer than MinPollOptions remain.
+func NormalizePollOptions(options []string) ([]string, error) {
+	var normalized []string
+	for _, opt := range options {
+		opt = strings.Join(strings.Fields(opt), " ")
+		if opt == "" {
+			continue
+		}
+		if runes := []rune(opt); len(runes) > MaxPollOptionLength {
+			opt = strings.TrimSpace(string(runes[:MaxPollOptionLength]))
+		}
+		normalized = append(normalized, opt)
+		if len(normalized) == MaxPollOptions {
+			break
+		}
+	}
+
+	if len(normalized) < MinPollOptions {
+		return nil, fmt.Errorf("poll needs at least %d options, got %d", MinPollOptions, len(normalized))
+	}
+	return normalized, nil
+}
+
+// truncateCommentary shortens content to maxChars characters, ending in "...". LinkedIn
+// counts characters, not bytes, and cutting by bytes could split a multi-byte character.
+func truncateCommentary(content string, maxChars int) string {
+	if utf8.RuneCountInString(content) <= maxChars {
+		return content
+	}
+	return string([]rune(content)[:maxChars-3]) + "..."
+}
+
 // sanitizeForLinkedIn cleans content to ensure LinkedIn API accepts it properly
 // LinkedIn's API can have issues with certain unicode characters
 func sanitizeForLinkedIn(content string) string {
@@ -399,14 +691,14 @@ func (c *Client) CreatePost(ctx context.Context, post *models.Post) (string, err
 		Int("sanitized_length", len(content)).
 		Msg("Content sanitized for LinkedIn")
 
-	// Truncate content if it exceeds LinkedIn's limit
-	if len(content) > maxCommentaryLength {
+	// Truncate content if it exceeds the configured limit
+	if n := utf8.RuneCountInString(content); n > c.maxChars {
 		c.log.Warn().
-			Int("original_length", len(content)).
-			Int("max_length", maxCommentaryLength).
-			Msg("Content exceeds LinkedIn limit, truncating")
+			Int("original_length", n).
+			Int("max_length", c.maxChars).
+			Msg("Content exceeds character limit, truncating")
 		// Truncate at a reasonable point (try to find last complete paragraph)
-		content = content[:maxCommentaryLength-3] + "..."
+		content = truncateCommentary(content, c.maxChars)
 	}
 
 	// Build the post request
@@ -480,8 +772,13 @@ func (c *Client) CreatePoll(ctx context.Context, question string, options []stri
 
 	// Sanitize question and options
 	question = sanitizeForLinkedIn(question)
+	sanitized := make([]string, len(options))
 	for i, opt := range options {
-		options[i] = sanitizeForLinkedIn(opt)
+		sanitized[i] = sanitizeForLinkedIn(opt)
+	}
+	options, err = NormalizePollOptions(sanitized)
+	if err != nil {
+		return "", fmt.Errorf("invalid poll: %w", err)
 	}
 
 	// Map duration to LinkedIn format
@@ -565,3 +862,133 @@ type PollOption struct {
 type PollSettings struct {
 	Duration string `json:"duration"`
 }
+
+// PollResults holds the vote distribution of a poll post
+type PollResults struct {
+	Question     string             `json:"question"`
+	Options      []PollOptionResult `json:"options"`
+	UniqueVoters int                `json:"uniqueVotersCount"`
+}
+
+// PollOptionResult is a poll option with its vote count
+type PollOptionResult struct {
+	Text      string `json:"text"`
+	VoteCount int    `json:"voteCount"`
+}
+
+// GetPollResults fetches the current vote counts of a poll post
+func (c *Client) GetPollResults(ctx context.Context, postURN string) (*PollResults, error) {
+	body, err := c.getPost(ctx, postURN)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Content struct {
+			Poll *PollResults `json:"poll"`
+		} `json:"content"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse poll response: %w", err)
+	}
+	if result.Content.Poll == nil {
+		return nil, fmt.Errorf("post %s is not a poll", postURN)
+	}
+
+	c.log.Debug().
+		Str("post_urn", postURN).
+		Int("unique_voters", result.Content.Poll.UniqueVoters).
+		Msg("Fetched poll results")
+
+	return result.Content.Poll, nil
+}
+
+// getPost returns the raw JSON of a post from the Posts API
+func (c *Client) getPost(ctx context.Context, postURN string) ([]byte, error) {
+	resp, err := c.do(ctx, "GET", "/posts/"+url.PathEscape(postURN), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch post: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch post: %s - %s", resp.Status, string(body))
+	}
+	return body, nil
+}
+
+// CreateArticlePost shares a long-form article on LinkedIn as an article post: the commentary
+// is shown in the feed with a card linking to the article at articleURL.
+// Note: LinkedIn's API does not allow apps to create native articles or newsletter editions,
+// so the article itself must be hosted at articleURL (e.g. published manually on LinkedIn).
+func (c *Client) CreateArticlePost(ctx context.Context, commentary, articleURL, title, description string) (string, error) {
+	profile, err := c.GetProfile(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get profile: %w", err)
+	}
+
+	commentary = sanitizeForLinkedIn(commentary)
+	commentary = truncateCommentary(commentary, c.maxChars)
+
+	articleReq := ArticlePostRequest{
+		Author:     fmt.Sprintf("urn:li:person:%s", profile.Sub),
+		Commentary: commentary,
+		Visibility: "PUBLIC",
+		Distribution: Distribution{
+			FeedDistribution:               "MAIN_FEED",
+			TargetEntities:                 []interface{}{},
+			ThirdPartyDistributionChannels: []interface{}{},
+		},
+		LifecycleState: "PUBLISHED",
+		Content: ArticleContent{
+			Article: Article{
+				Source:      articleURL,
+				Title:       sanitizeForLinkedIn(title),
+				Description: sanitizeForLinkedIn(description),
+			},
+		},
+	}
+
+	resp, err := c.do(ctx, "POST", "/posts", articleReq)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("failed to create article post: %s - %s", resp.Status, string(body))
+	}
+
+	postURN := resp.Header.Get("x-restli-id")
+	c.log.Info().
+		Str("post_urn", postURN).
+		Str("article_url", articleURL).
+		Msg("Article post created successfully")
+
+	return postURN, nil
+}
+
+// ArticlePostRequest represents an article share request
+type ArticlePostRequest struct {
+	Author         string         `json:"author"`
+	Commentary     string         `json:"commentary"`
+	Visibility     string         `json:"visibility"`
+	Distribution   Distribution   `json:"distribution"`
+	LifecycleState string         `json:"lifecycleState"`
+	Content        ArticleContent `json:"content"`
+}
+
+// ArticleContent wraps the article attached to a post
+type ArticleContent struct {
+	Article Article `json:"article"`
+}
+
+// Article represents the link card of an article post
+type Article struct {
+	Source      string `json:"source"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,275 @@
+package linkedin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/linkedin-agent/internal/config"
+	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/pkg/logger"
+	"github.com/linkedin-agent/pkg/ratelimit"
+)
+
+// fakeLinkedIn is an httptest server standing in for api.linkedin.com. Handlers are keyed
+// by "METHOD /path"; every request is recorded.
+type fakeLinkedIn struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	handlers map[string]http.HandlerFunc
+	requests []string
+}
+
+func newFakeLinkedIn(t *testing.T) *fakeLinkedIn {
+	t.Helper()
+
+	f := &fakeLinkedIn{handlers: make(map[string]http.HandlerFunc)}
+	f.handle("GET /v2/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"sub": "abc123", "name": "Test User"})
+	})
+
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Method + " " + r.URL.Path
+
+		f.mu.Lock()
+		f.requests = append(f.requests, key)
+		handler := f.handlers[key]
+		f.mu.Unlock()
+
+		if r.Header.Get("Authorization") != "Bearer test-token" && !strings.HasPrefix(r.URL.Path, "/upload") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if handler == nil {
+			t.Errorf("unexpected request %s", key)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(f.Close)
+
+	return f
+}
+
+func (f *fakeLinkedIn) handle(key string, handler http.HandlerFunc) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.handlers[key] = handler
+}
+
+// count returns how many requests were made to key
+func (f *fakeLinkedIn) count(key string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n := 0
+	for _, r := range f.requests {
+		if r == key {
+			n++
+		}
+	}
+	return n
+}
+
+func (f *fakeLinkedIn) client() *Client {
+	log := logger.New(logger.Config{Level: "error"})
+	oauth := NewOAuthManagerEnvOnly(config.LinkedInConfig{AccessToken: "test-token"}, log)
+
+	limiter := ratelimit.NewMultiLimiter()
+	limiter.AddLimiter(ratelimit.LimiterLinkedIn, 1000, 100)
+
+	return NewClient(oauth, limiter, log, WithBaseURL(f.URL))
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func decodeBody(t *testing.T, r *http.Request) map[string]interface{} {
+	t.Helper()
+
+	var body map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		t.Errorf("failed to decode request body: %v", err)
+	}
+	return body
+}
+
+func TestCreatePost(t *testing.T) {
+	f := newFakeLinkedIn(t)
+	f.handle("POST /v2/posts", func(w http.ResponseWriter, r *http.Request) {
+		body := decodeBody(t, r)
+		if body["author"] != "urn:li:person:abc123" {
+			t.Errorf("author = %v, want urn:li:person:abc123", body["author"])
+		}
+		if body["commentary"] != "Hello LinkedIn" {
+			t.Errorf("commentary = %v, want Hello LinkedIn", body["commentary"])
+		}
+		w.Header().Set("x-restli-id", "urn:li:share:1")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	urn, err := f.client().CreatePost(context.Background(), &models.Post{Content: "Hello LinkedIn"})
+	if err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+	if urn != "urn:li:share:1" {
+		t.Errorf("post URN = %q, want urn:li:share:1", urn)
+	}
+}
+
+func TestUploadAndCreateImagePost(t *testing.T) {
+	f := newFakeLinkedIn(t)
+	f.handle("POST /rest/images", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("action") != "initializeUpload" {
+			t.Errorf("action = %q, want initializeUpload", r.URL.Query().Get("action"))
+		}
+		body := decodeBody(t, r)
+		owner := body["initializeUploadRequest"].(map[string]interface{})["owner"]
+		if owner != "urn:li:person:abc123" {
+			t.Errorf("upload owner = %v, want urn:li:person:abc123", owner)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": map[string]interface{}{
+				"uploadUrl": f.URL + "/upload/1",
+				"image":     "urn:li:image:1",
+			},
+		})
+	})
+	f.handle("PUT /upload/1", func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "image/png" {
+			t.Errorf("upload Content-Type = %q, want image/png", ct)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	f.handle("POST /v2/posts", func(w http.ResponseWriter, r *http.Request) {
+		body := decodeBody(t, r)
+		media := body["content"].(map[string]interface{})["media"].(map[string]interface{})
+		if media["id"] != "urn:li:image:1" {
+			t.Errorf("media id = %v, want urn:li:image:1", media["id"])
+		}
+		w.Header().Set("x-restli-id", "urn:li:share:2")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	png := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 64)...)
+	postURN, imageURN, err := f.client().UploadAndCreateImagePost(context.Background(), &models.Post{Content: "With image"}, png)
+	if err != nil {
+		t.Fatalf("UploadAndCreateImagePost: %v", err)
+	}
+	if postURN != "urn:li:share:2" || imageURN != "urn:li:image:1" {
+		t.Errorf("got post %q, image %q; want urn:li:share:2, urn:li:image:1", postURN, imageURN)
+	}
+}
+
+func TestCreatePoll(t *testing.T) {
+	f := newFakeLinkedIn(t)
+	f.handle("POST /v2/posts", func(w http.ResponseWriter, r *http.Request) {
+		body := decodeBody(t, r)
+		poll := body["poll"].(map[string]interface{})
+		if poll["question"] != "Tabs or spaces?" {
+			t.Errorf("question = %v, want Tabs or spaces?", poll["question"])
+		}
+		if options := poll["options"].([]interface{}); len(options) != 2 {
+			t.Errorf("got %d options, want 2", len(options))
+		}
+		if duration := poll["settings"].(map[string]interface{})["duration"]; duration != "ONE_WEEK" {
+			t.Errorf("duration = %v, want ONE_WEEK", duration)
+		}
+		w.Header().Set("x-restli-id", "urn:li:share:3")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	urn, err := f.client().CreatePoll(context.Background(), "Tabs or spaces?", []string{"Tabs", "Spaces"}, 7)
+	if err != nil {
+		t.Fatalf("CreatePoll: %v", err)
+	}
+	if urn != "urn:li:share:3" {
+		t.Errorf("poll URN = %q, want urn:li:share:3", urn)
+	}
+}
+
+func TestCreatePostErrorResponse(t *testing.T) {
+	f := newFakeLinkedIn(t)
+	f.handle("POST /v2/posts", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]string{"message": "Content is a duplicate"})
+	})
+
+	_, err := f.client().CreatePost(context.Background(), &models.Post{Content: "Duplicate"})
+	if err == nil || !strings.Contains(err.Error(), "422") || !strings.Contains(err.Error(), "duplicate") {
+		t.Errorf("got error %v, want the 422 status and LinkedIn's message", err)
+	}
+}
+
+func TestCreatePostNotRetriedOnServerError(t *testing.T) {
+	f := newFakeLinkedIn(t)
+	f.handle("POST /v2/posts", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	if _, err := f.client().CreatePost(context.Background(), &models.Post{Content: "Maybe published"}); err == nil {
+		t.Fatal("CreatePost succeeded on a 502")
+	}
+	// LinkedIn may have created the post before the gateway failed
+	if n := f.count("POST /v2/posts"); n != 1 {
+		t.Errorf("post was sent %d times, want 1", n)
+	}
+}
+
+func TestCreatePostRetriedOnRateLimit(t *testing.T) {
+	f := newFakeLinkedIn(t)
+	f.handle("POST /v2/posts", func(w http.ResponseWriter, r *http.Request) {
+		if f.count("POST /v2/posts") == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("x-restli-id", "urn:li:share:4")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	urn, err := f.client().CreatePost(context.Background(), &models.Post{Content: "Eventually"})
+	if err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+	if urn != "urn:li:share:4" {
+		t.Errorf("post URN = %q, want urn:li:share:4", urn)
+	}
+	if n := f.count("POST /v2/posts"); n != 2 {
+		t.Errorf("post was sent %d times, want 2", n)
+	}
+}
+
+func TestGetProfileUnauthorized(t *testing.T) {
+	f := newFakeLinkedIn(t)
+	log := logger.New(logger.Config{Level: "error"})
+	oauth := NewOAuthManagerEnvOnly(config.LinkedInConfig{AccessToken: "expired-token"}, log)
+	limiter := ratelimit.NewMultiLimiter()
+	limiter.AddLimiter(ratelimit.LimiterLinkedIn, 1000, 100)
+	client := NewClient(oauth, limiter, log, WithBaseURL(f.URL))
+
+	_, err := client.GetProfile(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("got error %v, want a 401", err)
+	}
+}
+
+func TestTruncateCommentaryKeepsCharactersWhole(t *testing.T) {
+	content := strings.Repeat("é", 10) // 2 bytes each
+
+	got := truncateCommentary(content, 8)
+	if want := strings.Repeat("é", 5) + "..."; got != want {
+		t.Errorf("truncateCommentary = %q, want %q", got, want)
+	}
+	if got := truncateCommentary(content, 10); got != content {
+		t.Errorf("content within the limit was changed to %q", got)
+	}
+}
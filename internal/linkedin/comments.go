@@ -142,3 +142,53 @@ func (c *Client) GetPostsByAuthor(ctx context.Context, authorURN string, count i
 
 	return posts, nil
 }
+
+// GetConnections returns the person URNs of the authenticated member's 1st-degree connections.
+// Note: The connections API requires the r_1st_connections scope, which LinkedIn only grants
+// to approved partner apps. LinkedIn's public docs only describe the connection count, not
+// this list, so the response shape (elements[].to) is a best guess and may parse as empty.
+func (c *Client) GetConnections(ctx context.Context, count int) ([]string, error) {
+	if count <= 0 || count > 50 {
+		count = 50
+	}
+
+	endpoint := fmt.Sprintf("/connections?q=viewer&start=0&count=%d", count)
+
+	resp, err := c.do(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch connections: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		c.log.Warn().
+			Int("status", resp.StatusCode).
+			Str("body", string(body)).
+			Msg("Failed to fetch connections - API may require additional permissions")
+		return nil, fmt.Errorf("failed to fetch connections: %s", resp.Status)
+	}
+
+	var result struct {
+		Elements []struct {
+			To string `json:"to"`
+		} `json:"elements"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse connections response: %w", err)
+	}
+
+	urns := make([]string, 0, len(result.Elements))
+	for _, e := range result.Elements {
+		if e.To != "" {
+			urns = append(urns, e.To)
+		}
+	}
+
+	c.log.Debug().
+		Int("count", len(urns)).
+		Msg("Fetched connections")
+
+	return urns, nil
+}
@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/linkedin-agent/internal/models"
 )
@@ -93,15 +95,28 @@ func (c *Client) InitializeImageUpload(ctx context.Context, ownerURN string) (*I
 	return &uploadResp.Value, nil
 }
 
+// ImageContentType sniffs the MIME type of image data from its first 512 bytes and
+// returns an error when the data is not an image (e.g. an HTML error page)
+func ImageContentType(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	if !strings.HasPrefix(contentType, "image/") {
+		return "", fmt.Errorf("data is not an image (detected %s)", contentType)
+	}
+	return contentType, nil
+}
+
 // UploadImageToURL uploads image data to the provided upload URL
 func (c *Client) UploadImageToURL(ctx context.Context, uploadURL string, imageData []byte) error {
+	contentType, err := ImageContentType(imageData)
+	if err != nil {
+		return err
+	}
+
 	req, err := http.NewRequestWithContext(ctx, "PUT", uploadURL, bytes.NewReader(imageData))
 	if err != nil {
 		return fmt.Errorf("failed to create upload request: %w", err)
 	}
-
-	// Set content type based on image data (assume JPEG for now, could detect)
-	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Type", contentType)
 
 	// Don't use the OAuth client for the upload URL - it's a pre-signed URL
 	httpClient := &http.Client{Timeout: 60 * time.Second}
@@ -122,6 +137,7 @@ func (c *Client) UploadImageToURL(ctx context.Context, uploadURL string, imageDa
 
 	c.log.Info().
 		Int("size_bytes", len(imageData)).
+		Str("content_type", contentType).
 		Msg("Image uploaded successfully")
 
 	return nil
@@ -139,12 +155,12 @@ func (c *Client) CreatePostWithImage(ctx context.Context, post *models.Post, ima
 	content := sanitizeForLinkedIn(post.Content)
 
 	// Truncate if needed
-	if len(content) > maxCommentaryLength {
+	if n := utf8.RuneCountInString(content); n > c.maxChars {
 		c.log.Warn().
-			Int("original_length", len(content)).
-			Int("max_length", maxCommentaryLength).
-			Msg("Content exceeds LinkedIn limit, truncating")
-		content = content[:maxCommentaryLength-3] + "..."
+			Int("original_length", n).
+			Int("max_length", c.maxChars).
+			Msg("Content exceeds character limit, truncating")
+		content = truncateCommentary(content, c.maxChars)
 	}
 
 	postReq := ImagePostRequest{
@@ -196,6 +212,11 @@ func (c *Client) CreatePostWithImage(ctx context.Context, post *models.Post, ima
 
 // UploadAndCreateImagePost is a convenience method that handles the full image upload flow
 func (c *Client) UploadAndCreateImagePost(ctx context.Context, post *models.Post, imageData []byte) (string, string, error) {
+	// Check the data is an image before starting an upload
+	if _, err := ImageContentType(imageData); err != nil {
+		return "", "", err
+	}
+
 	// Get user profile
 	profile, err := c.GetProfile(ctx)
 	if err != nil {
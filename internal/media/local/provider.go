@@ -0,0 +1,109 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/linkedin-agent/internal/media"
+	"github.com/linkedin-agent/pkg/logger"
+)
+
+// Selection modes
+const (
+	SelectionRandom     = "random"
+	SelectionRoundRobin = "round_robin"
+)
+
+// imageExtensions are the file types LinkedIn accepts as post images
+var imageExtensions = map[string]bool{
+	".jpg":  true,
+	".jpeg": true,
+	".png":  true,
+	".gif":  true,
+}
+
+// Provider picks images from a local directory, for branded or consistent imagery
+type Provider struct {
+	dir       string
+	selection string
+	log       *logger.Logger
+
+	mu   sync.Mutex
+	next int // Next index for round-robin selection (per process)
+}
+
+// New creates a local image provider for dir. selection is "random" (default) or "round_robin".
+func New(dir, selection string, log *logger.Logger) *Provider {
+	if selection != SelectionRoundRobin {
+		selection = SelectionRandom
+	}
+	return &Provider{
+		dir:       dir,
+		selection: selection,
+		log:       log.WithComponent("local-images"),
+	}
+}
+
+// GetBestImage picks an image from the directory. Keywords are ignored: every image is
+// considered suitable for any post.
+func (p *Provider) GetBestImage(ctx context.Context, keywords []string) (string, string, string, error) {
+	images, err := p.listImages()
+	if err != nil {
+		return "", "", "", err
+	}
+	if len(images) == 0 {
+		return "", "", "", fmt.Errorf("no images found in %s", p.dir)
+	}
+
+	var idx int
+	if p.selection == SelectionRoundRobin {
+		p.mu.Lock()
+		idx = p.next % len(images)
+		p.next++
+		p.mu.Unlock()
+	} else {
+		idx = rand.Intn(len(images))
+	}
+
+	path := images[idx]
+	p.log.Debug().
+		Int("images", len(images)).
+		Int("selected_index", idx).
+		Str("file", filepath.Base(path)).
+		Msg("Selected local image")
+
+	return "file://" + path, "", filepath.Base(path), nil
+}
+
+// listImages returns the absolute paths of the image files in the directory, sorted by name
+func (p *Provider) listImages() ([]string, error) {
+	dir, err := filepath.Abs(p.dir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image directory: %w", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image directory: %w", err)
+	}
+
+	var images []string
+	for _, entry := range entries {
+		if entry.IsDir() || !imageExtensions[strings.ToLower(filepath.Ext(entry.Name()))] {
+			continue
+		}
+		images = append(images, filepath.Join(dir, entry.Name()))
+	}
+	sort.Strings(images)
+
+	return images, nil
+}
+
+// Ensure Provider implements media.Provider
+var _ media.Provider = (*Provider)(nil)
@@ -0,0 +1,10 @@
+package media
+
+import "context"
+
+// Provider selects an image to attach to a post
+type Provider interface {
+	// GetBestImage returns the URL, attribution and ID of an image for the search keywords,
+	// which are tried in order. Local images use file:// URLs.
+	GetBestImage(ctx context.Context, keywords []string) (url string, attribution string, id string, err error)
+}
@@ -0,0 +1,43 @@
+// Package providers builds the image provider selected in the media config. It lives apart
+// from package media because the providers themselves import media.
+package providers
+
+import (
+	"github.com/linkedin-agent/internal/config"
+	"github.com/linkedin-agent/internal/media"
+	"github.com/linkedin-agent/internal/media/local"
+	"github.com/linkedin-agent/internal/media/unsplash"
+	"github.com/linkedin-agent/pkg/logger"
+)
+
+// New returns the image provider selected by media.provider, or nil when images are off
+// or the provider isn't configured
+func New(cfg config.MediaConfig, log *logger.Logger) media.Provider {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	switch cfg.Provider {
+	case "local":
+		if cfg.LocalDir == "" {
+			log.Warn().Msg("Media provider is local but media.local_dir is not set, images disabled")
+			return nil
+		}
+		return local.New(cfg.LocalDir, cfg.LocalSelection, log)
+	case "", "unsplash":
+		if cfg.UnsplashAPIKey == "" {
+			return nil
+		}
+		return unsplash.NewClient(cfg.UnsplashAPIKey, log,
+			unsplash.WithBaseURL(cfg.UnsplashBaseURL),
+			unsplash.WithPhotoFilter(unsplash.PhotoFilter{
+				MinWidth:     cfg.MinWidth,
+				Orientation:  cfg.Orientation,
+				MinRelevance: cfg.MinRelevance,
+			}),
+		)
+	default:
+		log.Warn().Str("provider", cfg.Provider).Msg("Unknown media provider, images disabled")
+		return nil
+	}
+}
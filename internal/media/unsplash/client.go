@@ -8,18 +8,23 @@ import (
 	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/linkedin-agent/internal/media"
 	"github.com/linkedin-agent/pkg/logger"
 )
 
 const (
-	baseURL = "https://api.unsplash.com"
+	defaultBaseURL = "https://api.unsplash.com"
 )
 
 // Photo represents an Unsplash photo
 type Photo struct {
 	ID          string `json:"id"`
+	Width       int    `json:"width"`  // Original width in pixels
+	Height      int    `json:"height"` // Original height in pixels
 	Description string `json:"description"`
 	AltDesc     string `json:"alt_description"`
 	URLs        URLs   `json:"urls"`
@@ -27,6 +32,50 @@ type Photo struct {
 	Links       Links  `json:"links"`
 }
 
+// RegularWidth returns the width in pixels of the regular-size image that gets downloaded
+func (p *Photo) RegularWidth() int {
+	width := p.Width
+	if u, err := url.Parse(p.URLs.Regular); err == nil {
+		if w, err := strconv.Atoi(u.Query().Get("w")); err == nil && (width == 0 || w < width) {
+			width = w
+		}
+	}
+	return width
+}
+
+// Relevance returns the fraction of query words (3+ letters) found in the photo's
+// description or alt text. Matching is loose: "networks" matches "network".
+// Photos without any description score 0.
+func (p *Photo) Relevance(query string) float64 {
+	text := strings.ToLower(p.Description + " " + p.AltDesc)
+
+	words, matched := 0, 0
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		if len(word) < 3 {
+			continue
+		}
+		words++
+		if len(word) > 3 {
+			word = strings.TrimSuffix(word, "s")
+		}
+		if strings.Contains(text, word) {
+			matched++
+		}
+	}
+
+	if words == 0 {
+		return 1
+	}
+	return float64(matched) / float64(words)
+}
+
+// PhotoFilter narrows which search results GetBestPhotoFiltered may pick
+type PhotoFilter struct {
+	MinWidth     int     // Minimum width of the regular-size image in pixels (0 = any)
+	Orientation  string  // landscape, portrait or squarish (empty = landscape)
+	MinRelevance float64 // Min fraction of query words in the photo description, 0-1 (0 = off)
+}
+
 // URLs contains different size URLs for the photo
 type URLs struct {
 	Raw     string `json:"raw"`
@@ -58,23 +107,55 @@ type SearchResult struct {
 // Client is the Unsplash API client
 type Client struct {
 	apiKey     string
+	baseURL    string
+	filter     PhotoFilter // Applied by GetBestImage
 	httpClient *http.Client
 	log        *logger.Logger
 }
 
+// ClientOption configures a Client
+type ClientOption func(*Client)
+
+// WithBaseURL points the client at a different API host (e.g. a proxy or mock server).
+// An empty URL keeps the default.
+func WithBaseURL(url string) ClientOption {
+	return func(c *Client) {
+		if url != "" {
+			c.baseURL = strings.TrimSuffix(url, "/")
+		}
+	}
+}
+
+// WithPhotoFilter sets the size/relevance filter GetBestImage applies to search results
+func WithPhotoFilter(filter PhotoFilter) ClientOption {
+	return func(c *Client) {
+		c.filter = filter
+	}
+}
+
 // NewClient creates a new Unsplash client
-func NewClient(apiKey string, log *logger.Logger) *Client {
-	return &Client{
-		apiKey: apiKey,
+func NewClient(apiKey string, log *logger.Logger, opts ...ClientOption) *Client {
+	c := &Client{
+		apiKey:  apiKey,
+		baseURL: defaultBaseURL,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
 		log: log.WithComponent("unsplash"),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
-// SearchPhotos searches for photos matching the query
+// SearchPhotos searches for landscape photos matching the query
 func (c *Client) SearchPhotos(ctx context.Context, query string, perPage int) ([]Photo, error) {
+	return c.searchPhotos(ctx, query, perPage, "landscape") // Best for LinkedIn posts
+}
+
+// searchPhotos searches for photos matching the query in the given orientation
+func (c *Client) searchPhotos(ctx context.Context, query string, perPage int, orientation string) ([]Photo, error) {
 	if perPage <= 0 {
 		perPage = 5
 	}
@@ -82,11 +163,11 @@ func (c *Client) SearchPhotos(ctx context.Context, query string, perPage int) ([
 		perPage = 30
 	}
 
-	endpoint := fmt.Sprintf("%s/search/photos", baseURL)
+	endpoint := fmt.Sprintf("%s/search/photos", c.baseURL)
 	params := url.Values{}
 	params.Set("query", query)
 	params.Set("per_page", fmt.Sprintf("%d", perPage))
-	params.Set("orientation", "landscape") // Best for LinkedIn posts
+	params.Set("orientation", orientation)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"?"+params.Encode(), nil)
 	if err != nil {
@@ -176,19 +257,89 @@ func (c *Client) GetAttribution(photo *Photo) string {
 
 // GetBestPhoto searches and returns a random photo from top results for variety
 func (c *Client) GetBestPhoto(ctx context.Context, query string) (*Photo, error) {
-	photos, err := c.SearchPhotos(ctx, query, 10)
+	return c.GetBestPhotoFiltered(ctx, query, PhotoFilter{})
+}
+
+// GetBestPhotoFiltered returns a random photo from the top results that pass the filter
+func (c *Client) GetBestPhotoFiltered(ctx context.Context, query string, filter PhotoFilter) (*Photo, error) {
+	orientation := filter.Orientation
+	if orientation == "" {
+		orientation = "landscape"
+	}
+
+	// Fetch more results when filtering so enough candidates remain
+	perPage := 10
+	if filter.MinWidth > 0 || filter.MinRelevance > 0 {
+		perPage = 30
+	}
+
+	results, err := c.searchPhotos(ctx, query, perPage, orientation)
 	if err != nil {
 		return nil, err
 	}
-	if len(photos) == 0 {
+	if len(results) == 0 {
 		return nil, fmt.Errorf("no photos found for query: %s", query)
 	}
+
+	photos := make([]Photo, 0, len(results))
+	for _, photo := range results {
+		if filter.MinWidth > 0 && photo.RegularWidth() < filter.MinWidth {
+			continue
+		}
+		if filter.MinRelevance > 0 && photo.Relevance(query) < filter.MinRelevance {
+			continue
+		}
+		photos = append(photos, photo)
+	}
+	if len(photos) == 0 {
+		return nil, fmt.Errorf("none of %d photos for query %q passed the size/relevance filter", len(results), query)
+	}
+	if len(photos) > 10 {
+		photos = photos[:10]
+	}
+
 	// Randomly select from top results to avoid using the same image repeatedly
 	idx := rand.Intn(len(photos))
 	c.log.Debug().
-		Int("total_results", len(photos)).
+		Int("total_results", len(results)).
+		Int("passed_filter", len(photos)).
 		Int("selected_index", idx).
 		Str("photo_id", photos[idx].ID).
 		Msg("Randomly selected photo from search results")
 	return &photos[idx], nil
 }
+
+// GetBestImage returns the first photo passing the client's filter, trying the keywords in order
+func (c *Client) GetBestImage(ctx context.Context, keywords []string) (string, string, string, error) {
+	var lastErr error
+	tried := make(map[string]bool)
+
+	for _, query := range keywords {
+		if query == "" || tried[query] {
+			continue
+		}
+		tried[query] = true
+
+		photo, err := c.GetBestPhotoFiltered(ctx, query, c.filter)
+		if err != nil {
+			c.log.Warn().Err(err).Str("keyword", query).Msg("Failed to find image")
+			lastErr = err
+			continue
+		}
+
+		c.log.Info().
+			Str("photo_id", photo.ID).
+			Str("photographer", photo.User.Name).
+			Str("keyword", query).
+			Msg("Selected Unsplash photo")
+		return photo.URLs.Regular, c.GetAttribution(photo), photo.ID, nil
+	}
+
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no search keywords")
+	}
+	return "", "", "", fmt.Errorf("no suitable image found: %w", lastErr)
+}
+
+// Ensure Client implements media.Provider
+var _ media.Provider = (*Client)(nil)
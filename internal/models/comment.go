@@ -30,10 +30,19 @@ type Comment struct {
 	AIReasoning      string        `gorm:"type:text" json:"ai_reasoning"`   // AI's reasoning for the comment
 	PostEngagement   int           `json:"post_engagement"`                 // Engagement at time of comment
 	PostedAt         *time.Time    `json:"posted_at"`                       // When actually posted to LinkedIn
+	NextCommentAt    *time.Time    `json:"next_comment_at"`                 // Earliest time for the next comment (randomized interval)
 	CreatedAt        time.Time     `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt        time.Time     `gorm:"autoUpdateTime" json:"updated_at"`
 }
 
+// ExcludedAuthor is an author the commenter must never comment on (added at runtime)
+type ExcludedAuthor struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Author    string    `gorm:"size:255;uniqueIndex;not null" json:"author"` // Person URN or vanity name
+	Reason    string    `gorm:"size:500" json:"reason"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
 // TargetPost represents a LinkedIn post to potentially comment on
 type TargetPost struct {
 	URN                string    `json:"urn"`
@@ -44,4 +53,6 @@ type TargetPost struct {
 	CommentCount       int       `json:"comment_count"`
 	PublishedAt        time.Time `json:"published_at"`
 	EngagementVelocity float64   `json:"engagement_velocity"` // Engagements per hour since posted
+	FromConnection     bool      `json:"from_connection"`     // Author is a 1st-degree connection
+	MatchedKeyword     string    `json:"matched_keyword"`     // First commenter.prefer_keywords entry found in the content
 }
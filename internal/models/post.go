@@ -68,6 +68,15 @@ type PollFormat struct {
 	Duration string       `json:"duration"` // ONE_DAY, THREE_DAYS, ONE_WEEK, TWO_WEEKS
 }
 
+// PostStats holds the engagement numbers of a published post
+type PostStats struct {
+	Likes       int       `json:"likes"`
+	Comments    int       `json:"comments"`
+	Shares      int       `json:"shares"`
+	Impressions int       `json:"impressions"`
+	FetchedAt   time.Time `json:"fetched_at"`
+}
+
 // ShouldAutoPublish returns true if the post should be auto-published (high score topic)
 func (p *Post) ShouldAutoPublish() bool {
 	if p.Topic != nil {
@@ -80,3 +89,9 @@ func (p *Post) ShouldAutoPublish() bool {
 func (p *Post) CanRetry() bool {
 	return p.Status == PostStatusFailed && p.RetryCount < 3
 }
+
+// NextRetryAt returns the earliest time a failed post is retried automatically: backoff
+// after its last failure (UpdatedAt), doubled for every earlier failed attempt
+func (p *Post) NextRetryAt(backoff time.Duration) time.Time {
+	return p.UpdatedAt.Add(backoff << max(p.RetryCount-1, 0))
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// AIUsage holds one day's Claude requests and tokens, summed across every process that
+// shares the repository so the daily AI budget survives restarts
+type AIUsage struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	Day          string    `gorm:"size:10;uniqueIndex;not null" json:"day"` // 2006-01-02, local time
+	Requests     int       `json:"requests"`
+	InputTokens  int64     `json:"input_tokens"`
+	OutputTokens int64     `json:"output_tokens"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
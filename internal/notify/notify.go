@@ -0,0 +1,66 @@
+// Package notify sends operator alerts from the scheduler, such as an expiring LinkedIn
+// token, to a Slack-compatible incoming webhook.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/linkedin-agent/pkg/logger"
+)
+
+// Notifier posts alerts to a webhook. With no webhook URL it only logs.
+type Notifier struct {
+	webhookURL string
+	httpClient *http.Client
+	log        *logger.Logger
+}
+
+// New creates a notifier for webhookURL (empty = log only)
+func New(webhookURL string, log *logger.Logger) *Notifier {
+	return &Notifier{
+		webhookURL: webhookURL,
+		httpClient: &http.Client{Timeout: 15 * time.Second},
+		log:        log.WithComponent("notify"),
+	}
+}
+
+// Notify sends message as {"text": message}, the payload Slack, Mattermost and Discord's
+// /slack endpoint all accept. Failures are logged, never returned, so an unreachable
+// webhook can't fail the job that raised the alert.
+func (n *Notifier) Notify(ctx context.Context, message string) {
+	if n.webhookURL == "" {
+		return
+	}
+	if err := n.post(ctx, message); err != nil {
+		n.log.Warn().Err(err).Msg("Failed to send notification")
+	}
+}
+
+func (n *Notifier) post(ctx context.Context, message string) error {
+	body, err := json.Marshal(map[string]string{"text": message})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
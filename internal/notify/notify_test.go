@@ -0,0 +1,28 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/linkedin-agent/pkg/logger"
+)
+
+func TestNotifyPostsTextToWebhook(t *testing.T) {
+	var got map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Content-Type = %q, want application/json", ct)
+		}
+		json.NewDecoder(r.Body).Decode(&got)
+	}))
+	defer server.Close()
+
+	New(server.URL, logger.New(logger.Config{Level: "error"})).Notify(context.Background(), "token expires soon")
+
+	if got["text"] != "token expires soon" {
+		t.Errorf("webhook payload = %v, want text \"token expires soon\"", got)
+	}
+}
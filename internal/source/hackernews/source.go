@@ -0,0 +1,211 @@
+package hackernews
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/linkedin-agent/internal/config"
+	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/internal/source"
+	"github.com/linkedin-agent/pkg/logger"
+)
+
+const baseURL = "https://hacker-news.firebaseio.com/v0"
+
+// item represents a Hacker News item from the Firebase API
+type item struct {
+	ID          int    `json:"id"`
+	Type        string `json:"type"`
+	By          string `json:"by"`
+	Time        int64  `json:"time"`
+	Title       string `json:"title"`
+	URL         string `json:"url"`
+	Text        string `json:"text"`
+	Score       int    `json:"score"`
+	Descendants int    `json:"descendants"`
+	Dead        bool   `json:"dead"`
+	Deleted     bool   `json:"deleted"`
+}
+
+// Source implements TopicSource for the Hacker News API
+type Source struct {
+	feed       string
+	maxStories int
+	minScore   int
+	httpClient *http.Client
+	log        *logger.Logger
+}
+
+// New creates a new Hacker News source
+func New(cfg config.HackerNewsConfig, log *logger.Logger) *Source {
+	feed := cfg.Feed
+	if feed != "best" {
+		feed = "top"
+	}
+
+	maxStories := cfg.MaxStories
+	if maxStories <= 0 {
+		maxStories = 30
+	}
+
+	return &Source{
+		feed:       feed,
+		maxStories: maxStories,
+		minScore:   cfg.MinScore,
+		httpClient: &http.Client{Timeout: 15 * time.Second},
+		log:        log.WithSource("hackernews", feed),
+	}
+}
+
+// Name returns the source name
+func (s *Source) Name() string {
+	return "hackernews-" + s.feed
+}
+
+// Type returns "hackernews"
+func (s *Source) Type() string {
+	return "hackernews"
+}
+
+// Fetch retrieves top/best stories from Hacker News
+func (s *Source) Fetch(ctx context.Context) ([]*models.RawTopic, error) {
+	s.log.Debug().Str("feed", s.feed).Msg("Fetching Hacker News stories")
+
+	var ids []int
+	if err := s.get(ctx, fmt.Sprintf("%s/%sstories.json", baseURL, s.feed), &ids); err != nil {
+		return nil, fmt.Errorf("failed to fetch hacker news %s stories: %w", s.feed, err)
+	}
+
+	if len(ids) > s.maxStories {
+		ids = ids[:s.maxStories]
+	}
+
+	topics := make([]*models.RawTopic, 0, len(ids))
+
+	for _, id := range ids {
+		var story item
+		if err := s.get(ctx, fmt.Sprintf("%s/item/%d.json", baseURL, id), &story); err != nil {
+			s.log.Warn().Err(err).Int("id", id).Msg("Failed to fetch story, skipping")
+			continue
+		}
+
+		if story.Type != "story" || story.Dead || story.Deleted || story.Title == "" {
+			continue
+		}
+		if story.Score < s.minScore {
+			continue
+		}
+
+		// Skip stories older than 7 days
+		publishedAt := time.Unix(story.Time, 0)
+		if time.Since(publishedAt) > 7*24*time.Hour {
+			continue
+		}
+
+		discussionURL := fmt.Sprintf("https://news.ycombinator.com/item?id=%d", story.ID)
+		url := story.URL
+		if url == "" {
+			// Ask HN / Show HN posts without an external link
+			url = discussionURL
+		}
+
+		description := fmt.Sprintf("%d points and %d comments on Hacker News.", story.Score, story.Descendants)
+		if text := cleanText(story.Text); text != "" {
+			description = text + " " + description
+		}
+
+		topic := &models.RawTopic{
+			Title:       story.Title,
+			Description: description,
+			URL:         url,
+			SourceType:  "hackernews",
+			SourceName:  "Hacker News",
+			Keywords:    []string{"Hacker News"},
+			PublishedAt: publishedAt,
+			RawData: map[string]interface{}{
+				"hn_id":          story.ID,
+				"author":         story.By,
+				"score":          story.Score,
+				"comments":       story.Descendants,
+				"discussion_url": discussionURL,
+				"signal_score":   signalScore(story.Score),
+			},
+		}
+
+		topics = append(topics, topic)
+	}
+
+	s.log.Info().
+		Int("count", len(topics)).
+		Str("feed", s.feed).
+		Msg("Fetched Hacker News topics")
+
+	return topics, nil
+}
+
+// HealthCheck verifies the Hacker News API is accessible
+func (s *Source) HealthCheck(ctx context.Context) error {
+	var maxItem int
+	return s.get(ctx, baseURL+"/maxitem.json", &maxItem)
+}
+
+// get performs a GET request and decodes the JSON response into out
+func (s *Source) get(ctx context.Context, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("hacker news API error: status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// signalScore maps an HN score onto the 0-100 ranking scale (500+ points = 100)
+func signalScore(score int) float64 {
+	signal := float64(score) / 5
+	if signal > 100 {
+		signal = 100
+	}
+	return signal
+}
+
+// cleanText strips HTML from Ask/Show HN text and collapses whitespace
+func cleanText(text string) string {
+	text = html.UnescapeString(text)
+	text = strings.ReplaceAll(text, "<p>", " ")
+
+	var result strings.Builder
+	inTag := false
+	for _, r := range text {
+		if r == '<' {
+			inTag = true
+		} else if r == '>' {
+			inTag = false
+		} else if !inTag {
+			result.WriteRune(r)
+		}
+	}
+
+	return strings.Join(strings.Fields(result.String()), " ")
+}
+
+// Ensure Source implements source.TopicSource
+var _ source.TopicSource = (*Source)(nil)
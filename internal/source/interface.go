@@ -3,7 +3,10 @@ package source
 import (
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/linkedin-agent/internal/models"
 )
@@ -16,13 +19,29 @@ type TopicSource interface {
 	// Type returns the source type (rss, newsapi, reddit, twitter, custom)
 	Type() string
 
-	// Fetch retrieves topics from the source
+	// Fetch retrieves topics from the source. A source may return the topics it
+	// fetched before failing together with the error (partial results).
 	Fetch(ctx context.Context) ([]*models.RawTopic, error)
 
 	// HealthCheck verifies the source is accessible
 	HealthCheck(ctx context.Context) error
 }
 
+// SourceError is a fetch error tagged with the source it came from
+type SourceError struct {
+	Name string
+	Type string
+	Err  error
+}
+
+func (e *SourceError) Error() string {
+	return fmt.Sprintf("%s (%s): %v", e.Name, e.Type, e.Err)
+}
+
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
+
 // GenerateExternalID creates a unique ID for a topic based on source and URL
 func GenerateExternalID(sourceType, url string) string {
 	data := fmt.Sprintf("%s:%s", sourceType, url)
@@ -32,7 +51,9 @@ func GenerateExternalID(sourceType, url string) string {
 
 // Manager manages multiple topic sources
 type Manager struct {
-	sources []TopicSource
+	sources        []TopicSource
+	maxConcurrency int           // 0 = unlimited
+	fetchTimeout   time.Duration // 0 = no limit
 }
 
 // NewManager creates a new source manager
@@ -47,6 +68,35 @@ func (m *Manager) Register(source TopicSource) {
 	m.sources = append(m.sources, source)
 }
 
+// SetMaxConcurrency limits how many sources FetchAll and HealthCheckAll contact at once (0 = unlimited)
+func (m *Manager) SetMaxConcurrency(n int) {
+	m.maxConcurrency = n
+}
+
+// SetFetchTimeout bounds how long FetchAll waits for each source (0 = no limit)
+func (m *Manager) SetFetchTimeout(d time.Duration) {
+	m.fetchTimeout = d
+}
+
+// forEach runs fn for every source in its own goroutine, at most maxConcurrency at a time.
+// It returns immediately; fn is expected to report its result over a channel.
+func (m *Manager) forEach(fn func(s TopicSource)) {
+	var slots chan struct{}
+	if m.maxConcurrency > 0 {
+		slots = make(chan struct{}, m.maxConcurrency)
+	}
+
+	for _, source := range m.sources {
+		go func(s TopicSource) {
+			if slots != nil {
+				slots <- struct{}{}
+				defer func() { <-slots }()
+			}
+			fn(s)
+		}(source)
+	}
+}
+
 // GetSources returns all registered sources
 func (m *Manager) GetSources() []TopicSource {
 	return m.sources
@@ -73,7 +123,8 @@ func (m *Manager) GetSourcesByType(sourceType string) []TopicSource {
 	return result
 }
 
-// FetchAll fetches topics from all sources concurrently
+// FetchAll fetches topics from all sources concurrently, up to the max concurrency. Errors are returned as
+// *SourceError, sorted by source name.
 func (m *Manager) FetchAll(ctx context.Context) ([]*models.RawTopic, []error) {
 	type result struct {
 		topics []*models.RawTopic
@@ -82,12 +133,13 @@ func (m *Manager) FetchAll(ctx context.Context) ([]*models.RawTopic, []error) {
 
 	results := make(chan result, len(m.sources))
 
-	for _, source := range m.sources {
-		go func(s TopicSource) {
-			topics, err := s.Fetch(ctx)
-			results <- result{topics: topics, err: err}
-		}(source)
-	}
+	m.forEach(func(s TopicSource) {
+		topics, err := m.fetch(ctx, s)
+		if err != nil {
+			err = &SourceError{Name: s.Name(), Type: s.Type(), Err: err}
+		}
+		results <- result{topics: topics, err: err}
+	})
 
 	var allTopics []*models.RawTopic
 	var errors []error
@@ -96,10 +148,83 @@ func (m *Manager) FetchAll(ctx context.Context) ([]*models.RawTopic, []error) {
 		r := <-results
 		if r.err != nil {
 			errors = append(errors, r.err)
-		} else {
-			allTopics = append(allTopics, r.topics...)
 		}
+		// Keep partial results from sources that failed midway
+		allTopics = append(allTopics, r.topics...)
 	}
 
+	// Goroutines finish in any order; keep the report stable between runs
+	sort.Slice(errors, func(i, j int) bool {
+		return errors[i].(*SourceError).Name < errors[j].(*SourceError).Name
+	})
+
 	return allTopics, errors
 }
+
+// fetchTimeoutGrace is how long a timed-out source gets to return what it collected
+// after its context is cancelled
+const fetchTimeoutGrace = 5 * time.Second
+
+// fetch runs a single source's Fetch under the fetch timeout. When the timeout fires the
+// source's context is cancelled and it gets a short grace period to return the topics it
+// already collected, which are kept alongside the timeout error. A source that ignores the
+// context is given up on so one hanging source can't stall FetchAll.
+func (m *Manager) fetch(ctx context.Context, s TopicSource) ([]*models.RawTopic, error) {
+	if m.fetchTimeout <= 0 {
+		return s.Fetch(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, m.fetchTimeout)
+	defer cancel()
+
+	type result struct {
+		topics []*models.RawTopic
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		topics, err := s.Fetch(ctx)
+		done <- result{topics: topics, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.topics, r.err
+	case <-ctx.Done():
+	}
+
+	err := ctx.Err()
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = fmt.Errorf("fetch timed out after %s", m.fetchTimeout)
+	}
+
+	select {
+	case r := <-done:
+		return r.topics, err
+	case <-time.After(fetchTimeoutGrace):
+		return nil, err
+	}
+}
+
+// HealthCheckAll runs the health check of every source concurrently and returns the result
+// keyed by source name (nil for healthy sources)
+func (m *Manager) HealthCheckAll(ctx context.Context) map[string]error {
+	type result struct {
+		name string
+		err  error
+	}
+
+	results := make(chan result, len(m.sources))
+
+	m.forEach(func(s TopicSource) {
+		results <- result{name: s.Name(), err: s.HealthCheck(ctx)}
+	})
+
+	health := make(map[string]error, len(m.sources))
+	for range m.sources {
+		r := <-results
+		health[r.name] = r.err
+	}
+
+	return health
+}
@@ -0,0 +1,44 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/linkedin-agent/internal/models"
+)
+
+// slowSource collects one topic, then blocks until its context is cancelled
+type slowSource struct{}
+
+func (slowSource) Name() string { return "slow" }
+func (slowSource) Type() string { return "rss" }
+
+func (slowSource) Fetch(ctx context.Context) ([]*models.RawTopic, error) {
+	topics := []*models.RawTopic{{Title: "collected before the timeout"}}
+	<-ctx.Done()
+	return topics, nil
+}
+
+func (slowSource) HealthCheck(ctx context.Context) error { return nil }
+
+func TestFetchAllKeepsTopicsOfTimedOutSource(t *testing.T) {
+	m := NewManager()
+	m.SetFetchTimeout(50 * time.Millisecond)
+	m.Register(slowSource{})
+
+	topics, errs := m.FetchAll(context.Background())
+
+	if len(topics) != 1 {
+		t.Errorf("got %d topics, want the 1 collected before the timeout", len(topics))
+	}
+	if len(errs) != 1 {
+		t.Fatalf("got %d errors, want 1", len(errs))
+	}
+	var srcErr *SourceError
+	if !errors.As(errs[0], &srcErr) || srcErr.Name != "slow" || !strings.Contains(srcErr.Error(), "timed out") {
+		t.Errorf("got error %v, want a timeout tagged with the source name", errs[0])
+	}
+}
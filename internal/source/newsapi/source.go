@@ -0,0 +1,207 @@
+package newsapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/linkedin-agent/internal/config"
+	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/internal/source"
+	"github.com/linkedin-agent/pkg/logger"
+	"github.com/linkedin-agent/pkg/ratelimit"
+)
+
+const baseURL = "https://newsapi.org/v2"
+
+// pageSize is how many headlines are requested per category (NewsAPI max is 100)
+const pageSize = 30
+
+// article represents a NewsAPI article
+type article struct {
+	Source struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"source"`
+	Author      string `json:"author"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	URLToImage  string `json:"urlToImage"`
+	PublishedAt string `json:"publishedAt"`
+	Content     string `json:"content"`
+}
+
+// response represents the NewsAPI top-headlines response
+type response struct {
+	Status       string    `json:"status"`
+	Code         string    `json:"code"`
+	Message      string    `json:"message"`
+	TotalResults int       `json:"totalResults"`
+	Articles     []article `json:"articles"`
+}
+
+// Source implements TopicSource for NewsAPI top headlines
+type Source struct {
+	apiKey     string
+	categories []string
+	language   string
+	limiter    *ratelimit.MultiLimiter
+	httpClient *http.Client
+	log        *logger.Logger
+}
+
+// New creates a new NewsAPI source
+func New(cfg config.NewsAPIConfig, limiter *ratelimit.MultiLimiter, log *logger.Logger) *Source {
+	categories := cfg.Categories
+	if len(categories) == 0 {
+		categories = []string{"technology"}
+	}
+
+	language := cfg.Language
+	if language == "" {
+		language = "en"
+	}
+
+	return &Source{
+		apiKey:     cfg.APIKey,
+		categories: categories,
+		language:   language,
+		limiter:    limiter,
+		httpClient: &http.Client{Timeout: 15 * time.Second},
+		log:        log.WithSource("newsapi", "top-headlines"),
+	}
+}
+
+// Name returns the source name
+func (s *Source) Name() string {
+	return "newsapi"
+}
+
+// Type returns "newsapi"
+func (s *Source) Type() string {
+	return "newsapi"
+}
+
+// Fetch retrieves top headlines for each configured category
+func (s *Source) Fetch(ctx context.Context) ([]*models.RawTopic, error) {
+	var topics []*models.RawTopic
+	seen := make(map[string]bool)
+
+	for _, category := range s.categories {
+		s.log.Debug().Str("category", category).Msg("Fetching NewsAPI headlines")
+
+		articles, err := s.topHeadlines(ctx, category, pageSize)
+		if err != nil {
+			// Keep what we have from earlier categories
+			if len(topics) > 0 {
+				s.log.Warn().Err(err).Str("category", category).Msg("Failed to fetch category, skipping")
+				continue
+			}
+			return nil, fmt.Errorf("failed to fetch newsapi %s headlines: %w", category, err)
+		}
+
+		for _, a := range articles {
+			// NewsAPI marks articles taken down by the publisher as "[Removed]"
+			if a.Title == "" || a.URL == "" || a.Title == "[Removed]" || seen[a.URL] {
+				continue
+			}
+			seen[a.URL] = true
+
+			publishedAt, err := time.Parse(time.RFC3339, a.PublishedAt)
+			if err != nil {
+				publishedAt = time.Now()
+			}
+
+			// Skip articles older than 7 days
+			if time.Since(publishedAt) > 7*24*time.Hour {
+				continue
+			}
+
+			sourceName := a.Source.Name
+			if sourceName == "" {
+				sourceName = "NewsAPI"
+			}
+
+			topics = append(topics, &models.RawTopic{
+				Title:       a.Title,
+				Description: a.Description,
+				URL:         a.URL,
+				SourceType:  "newsapi",
+				SourceName:  sourceName,
+				Keywords:    []string{category},
+				PublishedAt: publishedAt,
+				RawData: map[string]interface{}{
+					"category":  category,
+					"author":    a.Author,
+					"image_url": a.URLToImage,
+					"source_id": a.Source.ID,
+				},
+			})
+		}
+	}
+
+	s.log.Info().
+		Int("count", len(topics)).
+		Strs("categories", s.categories).
+		Msg("Fetched NewsAPI topics")
+
+	return topics, nil
+}
+
+// HealthCheck verifies the NewsAPI key is accepted
+func (s *Source) HealthCheck(ctx context.Context) error {
+	_, err := s.topHeadlines(ctx, s.categories[0], 1)
+	return err
+}
+
+// topHeadlines requests the top headlines of one category
+func (s *Source) topHeadlines(ctx context.Context, category string, size int) ([]article, error) {
+	if s.apiKey == "" {
+		return nil, fmt.Errorf("newsapi api_key is not configured")
+	}
+
+	if err := s.limiter.Wait(ctx, ratelimit.LimiterNewsAPI); err != nil {
+		return nil, fmt.Errorf("rate limit error: %w", err)
+	}
+
+	params := url.Values{}
+	params.Set("category", category)
+	params.Set("language", s.language)
+	params.Set("pageSize", fmt.Sprintf("%d", size))
+
+	req, err := http.NewRequestWithContext(ctx, "GET", baseURL+"/top-headlines?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-Api-Key", s.apiKey)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var result response
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode response (status %d): %w", resp.StatusCode, err)
+	}
+
+	if resp.StatusCode != http.StatusOK || result.Status != "ok" {
+		return nil, fmt.Errorf("newsapi error (status %d): %s - %s", resp.StatusCode, result.Code, result.Message)
+	}
+
+	return result.Articles, nil
+}
+
+// Ensure Source implements source.TopicSource
+var _ source.TopicSource = (*Source)(nil)
@@ -0,0 +1,276 @@
+package reddit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkedin-agent/internal/config"
+	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/internal/source"
+	"github.com/linkedin-agent/pkg/logger"
+	"github.com/linkedin-agent/pkg/ratelimit"
+)
+
+const (
+	tokenURL  = "https://www.reddit.com/api/v1/access_token"
+	apiURL    = "https://oauth.reddit.com"
+	userAgent = "linkedin-agent/1.0 (topic discovery)"
+)
+
+// postsPerSubreddit is how many hot posts are fetched from each subreddit
+const postsPerSubreddit = 25
+
+// maxSelftextLength caps how many characters of a self post's text are kept as the description
+const maxSelftextLength = 500
+
+// post represents a Reddit link or self post
+type post struct {
+	ID          string  `json:"id"`
+	Title       string  `json:"title"`
+	Selftext    string  `json:"selftext"`
+	URL         string  `json:"url"`
+	Permalink   string  `json:"permalink"`
+	Author      string  `json:"author"`
+	Subreddit   string  `json:"subreddit"`
+	Score       int     `json:"score"`
+	NumComments int     `json:"num_comments"`
+	CreatedUTC  float64 `json:"created_utc"`
+	IsSelf      bool    `json:"is_self"`
+	Stickied    bool    `json:"stickied"`
+	Over18      bool    `json:"over_18"`
+}
+
+// listing represents a Reddit listing response
+type listing struct {
+	Data struct {
+		Children []struct {
+			Data post `json:"data"`
+		} `json:"children"`
+	} `json:"data"`
+}
+
+// Source implements TopicSource for Reddit using the app-only OAuth flow
+type Source struct {
+	clientID     string
+	clientSecret string
+	subreddits   []string
+	limiter      *ratelimit.MultiLimiter
+	httpClient   *http.Client
+	log          *logger.Logger
+
+	tokenMu     sync.Mutex
+	accessToken string
+	tokenExpiry time.Time
+}
+
+// New creates a new Reddit source
+func New(cfg config.RedditConfig, limiter *ratelimit.MultiLimiter, log *logger.Logger) *Source {
+	return &Source{
+		clientID:     cfg.ClientID,
+		clientSecret: cfg.ClientSecret,
+		subreddits:   cfg.Subreddits,
+		limiter:      limiter,
+		httpClient:   &http.Client{Timeout: 15 * time.Second},
+		log:          log.WithSource("reddit", strings.Join(cfg.Subreddits, ",")),
+	}
+}
+
+// Name returns the source name
+func (s *Source) Name() string {
+	return "reddit"
+}
+
+// Type returns "reddit"
+func (s *Source) Type() string {
+	return "reddit"
+}
+
+// Fetch retrieves hot posts from the configured subreddits
+func (s *Source) Fetch(ctx context.Context) ([]*models.RawTopic, error) {
+	var topics []*models.RawTopic
+
+	for _, subreddit := range s.subreddits {
+		s.log.Debug().Str("subreddit", subreddit).Msg("Fetching Reddit hot posts")
+
+		posts, err := s.hot(ctx, subreddit)
+		if err != nil {
+			// Keep what we have from earlier subreddits
+			if len(topics) > 0 {
+				s.log.Warn().Err(err).Str("subreddit", subreddit).Msg("Failed to fetch subreddit, skipping")
+				continue
+			}
+			return nil, fmt.Errorf("failed to fetch r/%s: %w", subreddit, err)
+		}
+
+		for _, p := range posts {
+			if p.Stickied || p.Over18 || p.Title == "" {
+				continue
+			}
+
+			// Skip posts older than 7 days
+			publishedAt := time.Unix(int64(p.CreatedUTC), 0)
+			if time.Since(publishedAt) > 7*24*time.Hour {
+				continue
+			}
+
+			discussionURL := "https://www.reddit.com" + p.Permalink
+			topicURL := p.URL
+			if p.IsSelf || topicURL == "" {
+				topicURL = discussionURL
+			}
+
+			description := fmt.Sprintf("%d upvotes and %d comments on r/%s.", p.Score, p.NumComments, p.Subreddit)
+			if text := strings.Join(strings.Fields(p.Selftext), " "); text != "" {
+				if runes := []rune(text); len(runes) > maxSelftextLength {
+					text = string(runes[:maxSelftextLength]) + "..."
+				}
+				description = text + " " + description
+			}
+
+			topics = append(topics, &models.RawTopic{
+				Title:       p.Title,
+				Description: description,
+				URL:         topicURL,
+				SourceType:  "reddit",
+				SourceName:  "r/" + p.Subreddit,
+				Keywords:    []string{p.Subreddit},
+				PublishedAt: publishedAt,
+				RawData: map[string]interface{}{
+					"reddit_id":      p.ID,
+					"author":         p.Author,
+					"subreddit":      p.Subreddit,
+					"score":          p.Score,
+					"comments":       p.NumComments,
+					"selftext":       p.Selftext,
+					"link_url":       p.URL,
+					"discussion_url": discussionURL,
+				},
+			})
+		}
+	}
+
+	s.log.Info().
+		Int("count", len(topics)).
+		Int("subreddits", len(s.subreddits)).
+		Msg("Fetched Reddit topics")
+
+	return topics, nil
+}
+
+// HealthCheck verifies the Reddit token endpoint accepts the app credentials
+func (s *Source) HealthCheck(ctx context.Context) error {
+	_, err := s.token(ctx)
+	return err
+}
+
+// hot requests the hot listing of one subreddit
+func (s *Source) hot(ctx context.Context, subreddit string) ([]post, error) {
+	token, err := s.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.limiter.Wait(ctx, ratelimit.LimiterReddit); err != nil {
+		return nil, fmt.Errorf("rate limit error: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/r/%s/hot?limit=%d&raw_json=1", apiURL, url.PathEscape(subreddit), postsPerSubreddit)
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("reddit API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var result listing
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	posts := make([]post, len(result.Data.Children))
+	for i, child := range result.Data.Children {
+		posts[i] = child.Data
+	}
+	return posts, nil
+}
+
+// token returns a cached app-only access token, requesting a new one when it has expired
+func (s *Source) token(ctx context.Context) (string, error) {
+	s.tokenMu.Lock()
+	defer s.tokenMu.Unlock()
+
+	if s.accessToken != "" && time.Now().Before(s.tokenExpiry) {
+		return s.accessToken, nil
+	}
+
+	if s.clientID == "" || s.clientSecret == "" {
+		return "", fmt.Errorf("reddit client_id and client_secret are not configured")
+	}
+
+	if err := s.limiter.Wait(ctx, ratelimit.LimiterReddit); err != nil {
+		return "", fmt.Errorf("rate limit error: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.SetBasicAuth(s.clientID, s.clientSecret)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("reddit token error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+		Error       string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+	if result.AccessToken == "" {
+		return "", fmt.Errorf("reddit token error: %s", result.Error)
+	}
+
+	// Refresh a minute early to avoid using a token right as it expires
+	s.accessToken = result.AccessToken
+	s.tokenExpiry = time.Now().Add(time.Duration(result.ExpiresIn)*time.Second - time.Minute)
+
+	s.log.Debug().Time("expires_at", s.tokenExpiry).Msg("Obtained Reddit access token")
+
+	return s.accessToken, nil
+}
+
+// Ensure Source implements source.TopicSource
+var _ source.TopicSource = (*Source)(nil)
@@ -0,0 +1,121 @@
+package rss
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const (
+	// thinDescriptionLength is the description length below which the linked article is fetched
+	thinDescriptionLength = 200
+
+	// maxFullTextLength caps the extracted article text kept as the topic description
+	maxFullTextLength = 3000
+
+	// minParagraphLength skips short paragraphs such as captions, bylines and share prompts
+	minParagraphLength = 40
+
+	// maxArticleBytes caps how much of an article page is read
+	maxArticleBytes = 2 << 20
+)
+
+// fullTextUserAgent identifies article fetches; some sites reject Go's default user agent
+const fullTextUserAgent = "linkedin-agent/1.0 (topic discovery)"
+
+// fetchArticleText downloads an article page and extracts its main body text
+func fetchArticleText(ctx context.Context, client *http.Client, articleURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", articleURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", fullTextUserAgent)
+	req.Header.Set("Accept", "text/html")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("article request failed: status %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.Contains(ct, "html") {
+		return "", fmt.Errorf("article is not HTML (%s)", ct)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(io.LimitReader(resp.Body, maxArticleBytes))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse article: %w", err)
+	}
+
+	return extractArticleText(doc), nil
+}
+
+// extractArticleText is a small readability-style extraction: page chrome is dropped, then
+// the paragraphs of the <article> or <main> element are used, or failing that the paragraphs
+// of whichever element holds the most paragraph text.
+func extractArticleText(doc *goquery.Document) string {
+	doc.Find("script, style, noscript, nav, header, footer, aside, form, figure").Remove()
+
+	container := doc.Find("article").First()
+	if container.Length() == 0 || paragraphText(container) == "" {
+		container = doc.Find("main").First()
+	}
+	if container.Length() == 0 || paragraphText(container) == "" {
+		container = densestParagraphParent(doc)
+	}
+	if container == nil {
+		return ""
+	}
+
+	text := paragraphText(container)
+	if runes := []rune(text); len(runes) > maxFullTextLength {
+		text = string(runes[:maxFullTextLength-3]) + "..."
+	}
+	return text
+}
+
+// paragraphText joins the non-trivial paragraphs under sel
+func paragraphText(sel *goquery.Selection) string {
+	var paragraphs []string
+	sel.Find("p").Each(func(_ int, p *goquery.Selection) {
+		text := strings.Join(strings.Fields(p.Text()), " ")
+		if len(text) >= minParagraphLength {
+			paragraphs = append(paragraphs, text)
+		}
+	})
+	return strings.Join(paragraphs, " ")
+}
+
+// densestParagraphParent returns the element whose direct paragraph children hold the most text
+func densestParagraphParent(doc *goquery.Document) *goquery.Selection {
+	var best *goquery.Selection
+	bestLength := 0
+
+	seen := make(map[interface{}]bool)
+	doc.Find("p").Each(func(_ int, p *goquery.Selection) {
+		parent := p.Parent()
+		if parent.Length() == 0 || seen[parent.Get(0)] {
+			return
+		}
+		seen[parent.Get(0)] = true
+
+		length := 0
+		parent.ChildrenFiltered("p").Each(func(_ int, child *goquery.Selection) {
+			if n := len(strings.TrimSpace(child.Text())); n >= minParagraphLength {
+				length += n
+			}
+		})
+		if length > bestLength {
+			best, bestLength = parent, length
+		}
+	})
+
+	return best
+}
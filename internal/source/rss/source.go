@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"html"
+	"net/http"
 	"strings"
 	"time"
 
@@ -13,41 +14,80 @@ import (
 	"github.com/linkedin-agent/internal/models"
 	"github.com/linkedin-agent/internal/source"
 	"github.com/linkedin-agent/pkg/logger"
+	"github.com/linkedin-agent/pkg/ratelimit"
 )
 
+// DefaultMaxAge is how old an item may be before it is skipped when sources.rss.max_age is unset
+const DefaultMaxAge = 7 * 24 * time.Hour
+
 // Source implements TopicSource for RSS feeds
 type Source struct {
 	name   string
 	url    string
+	weight float64
+	maxAge time.Duration
 	parser *gofeed.Parser
 	log    *logger.Logger
+
+	// Full-text extraction for items with thin descriptions (off when limiter is nil)
+	limiter    *ratelimit.MultiLimiter
+	httpClient *http.Client
 }
 
-// New creates a new RSS source for a single feed
-func New(feed config.RSSFeed, log *logger.Logger) *Source {
+// New creates a new RSS source for a single feed that keeps items published within maxAge
+func New(feed config.RSSFeed, maxAge time.Duration, log *logger.Logger) *Source {
+	weight := feed.Weight
+	if weight <= 0 {
+		weight = 1.0
+	}
+	if maxAge <= 0 {
+		maxAge = DefaultMaxAge
+	}
+
 	return &Source{
 		name:   feed.Name,
 		url:    feed.URL,
+		weight: weight,
+		maxAge: maxAge,
 		parser: gofeed.NewParser(),
 		log:    log.WithSource("rss", feed.Name),
 	}
 }
 
-// NewMultiple creates multiple RSS sources from config
-func NewMultiple(cfg config.RSSConfig, log *logger.Logger) []*Source {
+// NewMultiple creates multiple RSS sources from config. With sources.rss.fetch_full_text set,
+// article fetches for thin items share limiter's RSS rate limit.
+func NewMultiple(cfg config.RSSConfig, limiter *ratelimit.MultiLimiter, log *logger.Logger) []*Source {
+	maxAge := ParseMaxAge(cfg.MaxAge)
+
 	sources := make([]*Source, 0, len(cfg.Feeds))
 	for _, feed := range cfg.Feeds {
-		sources = append(sources, New(feed, log))
+		src := New(feed, maxAge, log)
+		if cfg.FetchFullText {
+			src.limiter = limiter
+			src.httpClient = &http.Client{Timeout: 15 * time.Second}
+		}
+		sources = append(sources, src)
 	}
 	return sources
 }
 
+// ParseMaxAge parses sources.rss.max_age, falling back to DefaultMaxAge when it is empty or
+// invalid (config.Load rejects invalid values, so that only happens for hand-built configs)
+func ParseMaxAge(value string) time.Duration {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return DefaultMaxAge
+	}
+	return d
+}
+
 // Name returns the source name
 func (s *Source) Name() string {
 	return s.name
 }
 
-// Type returns "rss"
+// Type returns "rss" for every feed format. The source type is part of each topic's
+// ExternalID, so it stays "rss"; the detected format is in RawData["feed_format"].
 func (s *Source) Type() string {
 	return "rss"
 }
@@ -58,26 +98,24 @@ func (s *Source) Fetch(ctx context.Context) ([]*models.RawTopic, error) {
 
 	feed, err := s.parser.ParseURLWithContext(s.url, ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse RSS feed %s: %w", s.name, err)
+		return nil, fmt.Errorf("failed to parse RSS feed: %w", err)
 	}
 
+	format := feedFormat(feed.FeedType)
 	topics := make([]*models.RawTopic, 0, len(feed.Items))
 
 	for _, item := range feed.Items {
-		// Skip items older than 7 days
-		publishedAt := time.Now()
-		if item.PublishedParsed != nil {
-			publishedAt = *item.PublishedParsed
-			if time.Since(publishedAt) > 7*24*time.Hour {
-				continue
-			}
+		// Skip items older than the max age
+		publishedAt, recent := s.itemAge(item)
+		if !recent {
+			continue
 		}
 
 		topic := &models.RawTopic{
 			Title:       cleanText(item.Title),
 			Description: cleanText(item.Description),
 			URL:         item.Link,
-			SourceType:  "rss",
+			SourceType:  s.Type(),
 			SourceName:  s.name,
 			Keywords:    extractKeywords(item),
 			PublishedAt: publishedAt,
@@ -87,8 +125,16 @@ func (s *Source) Fetch(ctx context.Context) ([]*models.RawTopic, error) {
 				"categories":  item.Categories,
 				"published":   item.Published,
 				"updated":     item.Updated,
+				"feed_format": format,
 			},
 		}
+		if s.weight != 1.0 {
+			topic.RawData["source_weight"] = s.weight
+		}
+		// Once the fetch deadline has passed, return the remaining items as they are
+		if s.limiter != nil && len(topic.Description) < thinDescriptionLength && topic.URL != "" && ctx.Err() == nil {
+			s.addFullText(ctx, topic)
+		}
 
 		topics = append(topics, topic)
 	}
@@ -101,12 +147,89 @@ func (s *Source) Fetch(ctx context.Context) ([]*models.RawTopic, error) {
 	return topics, nil
 }
 
+// addFullText replaces a thin description with the main text of the linked article.
+// Failures are logged and leave the feed's description in place.
+func (s *Source) addFullText(ctx context.Context, topic *models.RawTopic) {
+	if err := s.limiter.Wait(ctx, ratelimit.LimiterRSS); err != nil {
+		return
+	}
+
+	text, err := fetchArticleText(ctx, s.httpClient, topic.URL)
+	if err != nil {
+		s.log.Debug().Err(err).Str("url", topic.URL).Msg("Failed to fetch article text, keeping feed description")
+		return
+	}
+	if len(text) <= len(topic.Description) {
+		return
+	}
+
+	topic.Description = text
+	topic.RawData["full_text"] = true
+}
+
 // HealthCheck verifies the RSS feed is accessible
 func (s *Source) HealthCheck(ctx context.Context) error {
 	_, err := s.parser.ParseURLWithContext(s.url, ctx)
 	return err
 }
 
+// FeedPreview summarizes a parsed feed for validation before it is added to config
+type FeedPreview struct {
+	Title       string
+	FeedType    string
+	TotalItems  int
+	RecentItems []*models.RawTopic // Items that pass the age filter, as Fetch would return them
+}
+
+// Preview fetches and parses the feed, returning its title, item counts and recent items
+func (s *Source) Preview(ctx context.Context) (*FeedPreview, error) {
+	feed, err := s.parser.ParseURLWithContext(s.url, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse RSS feed %s: %w", s.name, err)
+	}
+
+	preview := &FeedPreview{
+		Title:      cleanText(feed.Title),
+		FeedType:   feed.FeedType,
+		TotalItems: len(feed.Items),
+	}
+
+	for _, item := range feed.Items {
+		publishedAt, recent := s.itemAge(item)
+		if !recent {
+			continue
+		}
+		preview.RecentItems = append(preview.RecentItems, &models.RawTopic{
+			Title:       cleanText(item.Title),
+			URL:         item.Link,
+			PublishedAt: publishedAt,
+		})
+	}
+
+	return preview, nil
+}
+
+// feedFormat maps gofeed's detected feed type to "rss", "atom" or "json"
+func feedFormat(feedType string) string {
+	switch feedType {
+	case "atom":
+		return "atom"
+	case "json":
+		return "json"
+	default:
+		return "rss"
+	}
+}
+
+// itemAge returns the item's publish time and whether it is recent enough (within maxAge) to use.
+// Items without a publish date are treated as published now.
+func (s *Source) itemAge(item *gofeed.Item) (time.Time, bool) {
+	if item.PublishedParsed == nil {
+		return time.Now(), true
+	}
+	return *item.PublishedParsed, time.Since(*item.PublishedParsed) <= s.maxAge
+}
+
 // cleanText removes HTML tags, decodes HTML entities, and cleans whitespace
 func cleanText(text string) string {
 	// Decode HTML entities first (e.g., &#8217; -> ', &amp; -> &)
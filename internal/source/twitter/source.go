@@ -0,0 +1,229 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/linkedin-agent/internal/config"
+	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/internal/source"
+	"github.com/linkedin-agent/pkg/logger"
+	"github.com/linkedin-agent/pkg/ratelimit"
+)
+
+const baseURL = "https://api.twitter.com/2"
+
+// resultsPerQuery is how many tweets are requested per search query (API allows 10-100)
+const resultsPerQuery = 25
+
+// ErrRateLimited is returned (alongside any topics already fetched) when the API responds with 429
+var ErrRateLimited = errors.New("twitter API rate limit reached")
+
+// tweet represents a tweet from the v2 API
+type tweet struct {
+	ID            string `json:"id"`
+	Text          string `json:"text"`
+	AuthorID      string `json:"author_id"`
+	CreatedAt     string `json:"created_at"`
+	Lang          string `json:"lang"`
+	PublicMetrics struct {
+		RetweetCount int `json:"retweet_count"`
+		ReplyCount   int `json:"reply_count"`
+		LikeCount    int `json:"like_count"`
+		QuoteCount   int `json:"quote_count"`
+	} `json:"public_metrics"`
+}
+
+// searchResponse represents the recent search response
+type searchResponse struct {
+	Data     []tweet `json:"data"`
+	Includes struct {
+		Users []struct {
+			ID       string `json:"id"`
+			Name     string `json:"name"`
+			Username string `json:"username"`
+		} `json:"users"`
+	} `json:"includes"`
+}
+
+// Source implements TopicSource for the Twitter/X recent search API
+type Source struct {
+	bearerToken string
+	queries     []string
+	limiter     *ratelimit.MultiLimiter
+	httpClient  *http.Client
+	log         *logger.Logger
+}
+
+// New creates a new Twitter source
+func New(cfg config.TwitterConfig, limiter *ratelimit.MultiLimiter, log *logger.Logger) *Source {
+	return &Source{
+		bearerToken: cfg.BearerToken,
+		queries:     cfg.SearchQueries,
+		limiter:     limiter,
+		httpClient:  &http.Client{Timeout: 15 * time.Second},
+		log:         log.WithSource("twitter", "recent-search"),
+	}
+}
+
+// Name returns the source name
+func (s *Source) Name() string {
+	return "twitter"
+}
+
+// Type returns "twitter"
+func (s *Source) Type() string {
+	return "twitter"
+}
+
+// Fetch runs each configured search query and converts the tweets into topics.
+// When the API rate limit is hit, the topics fetched so far are returned with ErrRateLimited.
+// A failing query is skipped, but if every query fails Fetch returns an error.
+func (s *Source) Fetch(ctx context.Context) ([]*models.RawTopic, error) {
+	var topics []*models.RawTopic
+	var failed int
+	var lastErr error
+
+	for _, query := range s.queries {
+		s.log.Debug().Str("query", query).Msg("Searching recent tweets")
+
+		result, err := s.search(ctx, query, resultsPerQuery)
+		if errors.Is(err, ErrRateLimited) {
+			s.log.Warn().
+				Str("query", query).
+				Int("topics_so_far", len(topics)).
+				Msg("Twitter rate limit reached, returning partial results")
+			return topics, err
+		}
+		if err != nil {
+			s.log.Warn().Err(err).Str("query", query).Msg("Failed to search tweets, skipping query")
+			failed++
+			lastErr = err
+			continue
+		}
+
+		usernames := make(map[string]string, len(result.Includes.Users))
+		for _, u := range result.Includes.Users {
+			usernames[u.ID] = u.Username
+		}
+
+		for _, t := range result.Data {
+			publishedAt, err := time.Parse(time.RFC3339, t.CreatedAt)
+			if err != nil {
+				publishedAt = time.Now()
+			}
+
+			username := usernames[t.AuthorID]
+			tweetURL := fmt.Sprintf("https://x.com/i/web/status/%s", t.ID)
+			if username != "" {
+				tweetURL = fmt.Sprintf("https://x.com/%s/status/%s", username, t.ID)
+			}
+
+			text := strings.Join(strings.Fields(t.Text), " ")
+			topics = append(topics, &models.RawTopic{
+				Title:       tweetTitle(text),
+				Description: text,
+				URL:         tweetURL,
+				SourceType:  "twitter",
+				SourceName:  "X (" + query + ")",
+				Keywords:    []string{query},
+				PublishedAt: publishedAt,
+				RawData: map[string]interface{}{
+					"tweet_id": t.ID,
+					"author":   username,
+					"likes":    t.PublicMetrics.LikeCount,
+					"retweets": t.PublicMetrics.RetweetCount,
+					"replies":  t.PublicMetrics.ReplyCount,
+					"quotes":   t.PublicMetrics.QuoteCount,
+					"query":    query,
+				},
+			})
+		}
+	}
+
+	if failed > 0 && failed == len(s.queries) {
+		return nil, fmt.Errorf("all %d twitter queries failed: %w", failed, lastErr)
+	}
+
+	s.log.Info().
+		Int("count", len(topics)).
+		Int("queries", len(s.queries)).
+		Msg("Fetched Twitter topics")
+
+	return topics, nil
+}
+
+// HealthCheck verifies the bearer token is accepted by the search API
+func (s *Source) HealthCheck(ctx context.Context) error {
+	query := "news"
+	if len(s.queries) > 0 {
+		query = s.queries[0]
+	}
+	_, err := s.search(ctx, query, 10)
+	return err
+}
+
+// search runs a recent search query (original English tweets only)
+func (s *Source) search(ctx context.Context, query string, maxResults int) (*searchResponse, error) {
+	if s.bearerToken == "" {
+		return nil, fmt.Errorf("twitter bearer_token is not configured")
+	}
+
+	if err := s.limiter.Wait(ctx, ratelimit.LimiterTwitter); err != nil {
+		return nil, fmt.Errorf("rate limit error: %w", err)
+	}
+
+	params := url.Values{}
+	params.Set("query", query+" -is:retweet -is:reply lang:en")
+	params.Set("max_results", fmt.Sprintf("%d", maxResults))
+	params.Set("tweet.fields", "created_at,public_metrics,author_id,lang")
+	params.Set("expansions", "author_id")
+	params.Set("user.fields", "username")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", baseURL+"/tweets/search/recent?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+s.bearerToken)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, fmt.Errorf("%w (resets at %s)", ErrRateLimited, resp.Header.Get("x-rate-limit-reset"))
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("twitter API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var result searchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// tweetTitle shortens a tweet to a headline-like length
+func tweetTitle(text string) string {
+	const maxTitleLength = 120
+	runes := []rune(text)
+	if len(runes) <= maxTitleLength {
+		return text
+	}
+	return string(runes[:maxTitleLength-3]) + "..."
+}
+
+// Ensure Source implements source.TopicSource
+var _ source.TopicSource = (*Source)(nil)
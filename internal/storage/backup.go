@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/linkedin-agent/internal/models"
+)
+
+// Backup is a backend-agnostic snapshot of the repository contents
+type Backup struct {
+	Version    int                `json:"version"`
+	ExportedAt time.Time          `json:"exported_at"`
+	Topics     []*models.Topic    `json:"topics"`
+	Posts      []*models.Post     `json:"posts"`
+	Comments   []*models.Comment  `json:"comments"`
+	Token      *models.OAuthToken `json:"oauth_token,omitempty"`
+}
+
+// ImportResult contains the counts of a backup import
+type ImportResult struct {
+	TopicsImported   int
+	TopicsSkipped    int
+	PostsImported    int
+	PostsSkipped     int
+	CommentsImported int
+	CommentsSkipped  int
+	TokenImported    bool
+	Errors           []error
+}
+
+// Export reads all topics, posts and comments from the repository. The LinkedIn OAuth
+// token is only included when includeSecrets is set.
+func Export(ctx context.Context, repo Repository, includeSecrets bool) (*Backup, error) {
+	backup := &Backup{
+		Version:    1,
+		ExportedAt: time.Now(),
+	}
+
+	topics, err := repo.ListTopics(ctx, TopicFilter{OrderBy: "id"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list topics: %w", err)
+	}
+	backup.Topics = topics
+
+	posts, err := repo.ListPosts(ctx, PostFilter{OrderBy: "id"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list posts: %w", err)
+	}
+	for _, p := range posts {
+		p.Topic = nil
+	}
+	backup.Posts = posts
+
+	// Comments are not supported by every backend
+	if comments, err := repo.ListComments(ctx, CommentFilter{OrderBy: "id"}); err == nil {
+		backup.Comments = comments
+	}
+
+	if includeSecrets {
+		if token, err := repo.GetToken(ctx, "linkedin"); err == nil && token != nil {
+			backup.Token = token
+		}
+	}
+
+	return backup, nil
+}
+
+// Import writes a backup into the repository. Topics already present (same external ID) and
+// posts already present (see postKey) are skipped, so a backup can be imported more than
+// once, and post topic references are remapped to the IDs assigned on import.
+func Import(ctx context.Context, repo Repository, backup *Backup) *ImportResult {
+	result := &ImportResult{}
+	topicIDs := make(map[uint]uint)
+
+	for _, t := range backup.Topics {
+		oldID := t.ID
+		if existing, _ := repo.GetTopicByExternalID(ctx, t.ExternalID); existing != nil {
+			topicIDs[oldID] = existing.ID
+			result.TopicsSkipped++
+			continue
+		}
+
+		t.ID = 0
+		if err := repo.CreateTopic(ctx, t); err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("topic %d: %w", oldID, err))
+			continue
+		}
+		topicIDs[oldID] = t.ID
+		result.TopicsImported++
+	}
+
+	importPosts(ctx, repo, backup.Posts, topicIDs, result)
+
+	for _, c := range backup.Comments {
+		if existing, _ := repo.GetCommentByTargetURN(ctx, c.TargetPostURN); existing != nil {
+			result.CommentsSkipped++
+			continue
+		}
+
+		oldID := c.ID
+		c.ID = 0
+		if err := repo.CreateComment(ctx, c); err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("comment %d: %w", oldID, err))
+			continue
+		}
+		result.CommentsImported++
+	}
+
+	if backup.Token != nil && backup.Token.AccessToken != "" {
+		backup.Token.ID = 0
+		if err := repo.SaveToken(ctx, backup.Token); err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("oauth token: %w", err))
+		} else {
+			result.TokenImported = true
+		}
+	}
+
+	return result
+}
+
+// importPosts creates the backup's posts that aren't in the repository yet, pointing them
+// at the topic IDs assigned on import
+func importPosts(ctx context.Context, repo Repository, posts []*models.Post, topicIDs map[uint]uint, result *ImportResult) {
+	existingPosts, err := repo.ListPosts(ctx, PostFilter{})
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Errorf("failed to list posts, skipping post import: %w", err))
+		return
+	}
+	importedPosts := postKeys(existingPosts)
+
+	for _, p := range posts {
+		oldID := p.ID
+		p.ID = 0
+		p.Topic = nil
+		if p.TopicID != nil {
+			if newID, ok := topicIDs[*p.TopicID]; ok {
+				p.TopicID = &newID
+			} else {
+				p.TopicID = nil
+			}
+		}
+
+		key := postKey(p)
+		if importedPosts[key] {
+			result.PostsSkipped++
+			continue
+		}
+
+		if err := repo.CreatePost(ctx, p); err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("post %d: %w", oldID, err))
+			continue
+		}
+		importedPosts[key] = true
+		result.PostsImported++
+	}
+}
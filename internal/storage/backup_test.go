@@ -0,0 +1,84 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/internal/storage"
+)
+
+func TestExportLeavesOutTokenByDefault(t *testing.T) {
+	ctx := context.Background()
+	repo := newSQLiteRepo(t, "repo.db")
+
+	token := &models.OAuthToken{Provider: "linkedin", AccessToken: "secret", ExpiresAt: time.Now().Add(time.Hour)}
+	if err := repo.SaveToken(ctx, token); err != nil {
+		t.Fatalf("SaveToken: %v", err)
+	}
+
+	backup, err := storage.Export(ctx, repo, false)
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	if backup.Token != nil {
+		t.Errorf("backup includes the OAuth token without includeSecrets")
+	}
+
+	backup, err = storage.Export(ctx, repo, true)
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	if backup.Token == nil || backup.Token.AccessToken != "secret" {
+		t.Errorf("backup is missing the OAuth token with includeSecrets")
+	}
+}
+
+func TestImportTwiceSkipsImportedPosts(t *testing.T) {
+	ctx := context.Background()
+	src := newSQLiteRepo(t, "src.db")
+	dst := newSQLiteRepo(t, "dst.db")
+
+	topic := &models.Topic{ExternalID: "rss_1", Title: "Topic", Status: models.TopicStatusApproved}
+	if err := src.CreateTopic(ctx, topic); err != nil {
+		t.Fatalf("CreateTopic: %v", err)
+	}
+	for _, p := range []*models.Post{
+		{TopicID: &topic.ID, Content: "published", Status: models.PostStatusPublished, LinkedInPostURN: "urn:li:share:1"},
+		{TopicID: &topic.ID, Content: "draft", Status: models.PostStatusDraft},
+	} {
+		if err := src.CreatePost(ctx, p); err != nil {
+			t.Fatalf("CreatePost: %v", err)
+		}
+	}
+
+	for run := 1; run <= 2; run++ {
+		// Each import gets a fresh backup, as when reading the same file twice
+		backup, err := storage.Export(ctx, src, false)
+		if err != nil {
+			t.Fatalf("Export: %v", err)
+		}
+		result := storage.Import(ctx, dst, backup)
+		if len(result.Errors) > 0 {
+			t.Fatalf("run %d: Import errors: %v", run, result.Errors)
+		}
+
+		wantImported, wantSkipped := 2, 0
+		if run == 2 {
+			wantImported, wantSkipped = 0, 2
+		}
+		if result.PostsImported != wantImported || result.PostsSkipped != wantSkipped {
+			t.Errorf("run %d: imported %d, skipped %d posts; want %d, %d",
+				run, result.PostsImported, result.PostsSkipped, wantImported, wantSkipped)
+		}
+	}
+
+	posts, err := dst.ListPosts(ctx, storage.PostFilter{})
+	if err != nil {
+		t.Fatalf("ListPosts: %v", err)
+	}
+	if len(posts) != 2 {
+		t.Errorf("repository has %d posts after importing twice, want 2", len(posts))
+	}
+}
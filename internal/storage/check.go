@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/linkedin-agent/internal/models"
+)
+
+// IntegrityReport lists dangling references found by CheckIntegrity
+type IntegrityReport struct {
+	PostsChecked    int
+	CommentsChecked int
+	OrphanedPosts   []OrphanedPost    // Posts whose TopicID points to a missing topic
+	InvalidComments []*models.Comment // Comments without a target post URN
+	Fixed           int
+	Errors          []error
+}
+
+// OrphanedPost is a post referencing a topic that no longer exists. TopicID is kept here
+// because fixing the post clears its own reference.
+type OrphanedPost struct {
+	Post    *models.Post
+	TopicID uint
+}
+
+// CheckIntegrity finds posts referencing deleted topics and comments missing their target post.
+// With fix set, orphaned posts have their TopicID cleared and invalid comments are marked skipped.
+func CheckIntegrity(ctx context.Context, repo Repository, fix bool) (*IntegrityReport, error) {
+	report := &IntegrityReport{}
+
+	topics, err := repo.ListTopics(ctx, TopicFilter{OrderBy: "id"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list topics: %w", err)
+	}
+	topicIDs := make(map[uint]bool, len(topics))
+	for _, t := range topics {
+		topicIDs[t.ID] = true
+	}
+
+	posts, err := repo.ListPosts(ctx, PostFilter{OrderBy: "id"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list posts: %w", err)
+	}
+	report.PostsChecked = len(posts)
+
+	for _, p := range posts {
+		if p.TopicID == nil || topicIDs[*p.TopicID] {
+			continue
+		}
+		missingID := *p.TopicID
+		report.OrphanedPosts = append(report.OrphanedPosts, OrphanedPost{Post: p, TopicID: missingID})
+
+		if fix {
+			p.TopicID = nil
+			p.Topic = nil
+			if err := repo.UpdatePost(ctx, p); err != nil {
+				p.TopicID = &missingID
+				report.Errors = append(report.Errors, fmt.Errorf("post %d: %w", p.ID, err))
+				continue
+			}
+			report.Fixed++
+		}
+	}
+
+	// Comments are not supported by every backend
+	comments, err := repo.ListComments(ctx, CommentFilter{OrderBy: "id"})
+	if err != nil {
+		return report, nil
+	}
+	report.CommentsChecked = len(comments)
+
+	for _, c := range comments {
+		if c.TargetPostURN != "" {
+			continue
+		}
+		report.InvalidComments = append(report.InvalidComments, c)
+
+		if fix && c.Status != models.CommentStatusSkipped {
+			c.Status = models.CommentStatusSkipped
+			c.ErrorMessage = "missing target post URN"
+			if err := repo.UpdateComment(ctx, c); err != nil {
+				report.Errors = append(report.Errors, fmt.Errorf("comment %d: %w", c.ID, err))
+				continue
+			}
+			report.Fixed++
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,151 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/linkedin-agent/internal/models"
+)
+
+// CopyResult contains the counts of a copy between two repositories
+type CopyResult struct {
+	TopicsCopied     int
+	TopicsSkipped    int // Already present in the destination (same external ID)
+	TopicsRenumbered int // Copied under a new ID because the destination already used theirs
+	PostsCopied      int
+	PostsSkipped     int // Already present in the destination (same LinkedIn URN, or topic, creation time and content)
+	PostsRenumbered  int
+	TokenCopied      bool
+	Errors           []error
+}
+
+// Copy copies all topics, posts and the LinkedIn OAuth token from src into dst, for moving
+// between backends. Records keep their IDs unless dst already uses them, in which case they get
+// a new one and post topic references follow. Topics and posts already in dst are skipped, so
+// a copy can be re-run, and the token is only copied when dst has none. Comments are not copied since the Sheets backend does not
+// store them.
+func Copy(ctx context.Context, src, dst Repository) (*CopyResult, error) {
+	result := &CopyResult{}
+
+	topics, err := src.ListTopics(ctx, TopicFilter{OrderBy: "id"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list source topics: %w", err)
+	}
+	posts, err := src.ListPosts(ctx, PostFilter{OrderBy: "id"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list source posts: %w", err)
+	}
+
+	// Read the destination once up front rather than looking up every record
+	existingTopics, err := dst.ListTopics(ctx, TopicFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list destination topics: %w", err)
+	}
+	existingPosts, err := dst.ListPosts(ctx, PostFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list destination posts: %w", err)
+	}
+
+	topicIDs := make(map[uint]uint, len(topics))
+	usedTopicIDs := make(map[uint]bool, len(existingTopics))
+	byExternalID := make(map[string]uint, len(existingTopics))
+	for _, t := range existingTopics {
+		usedTopicIDs[t.ID] = true
+		byExternalID[t.ExternalID] = t.ID
+	}
+
+	// Topics keeping their ID are written before renumbered ones so a newly assigned ID
+	// can't land on one that is still to be copied
+	var kept, renumbered []*models.Topic
+	var keptOldIDs, renumberedOldIDs []uint
+	for _, t := range topics {
+		if id, ok := byExternalID[t.ExternalID]; ok {
+			topicIDs[t.ID] = id
+			result.TopicsSkipped++
+			continue
+		}
+		if usedTopicIDs[t.ID] {
+			renumberedOldIDs = append(renumberedOldIDs, t.ID)
+			t.ID = 0
+			renumbered = append(renumbered, t)
+			continue
+		}
+		keptOldIDs = append(keptOldIDs, t.ID)
+		kept = append(kept, t)
+	}
+
+	// Batches keep Sheets well under its write quota
+	for _, batch := range []struct {
+		topics []*models.Topic
+		oldIDs []uint
+	}{{kept, keptOldIDs}, {renumbered, renumberedOldIDs}} {
+		if len(batch.topics) == 0 {
+			continue
+		}
+		if _, err := dst.CreateTopicsBatch(ctx, batch.topics); err != nil {
+			return result, fmt.Errorf("failed to copy topics: %w", err)
+		}
+		for i, t := range batch.topics {
+			topicIDs[batch.oldIDs[i]] = t.ID
+		}
+		result.TopicsCopied += len(batch.topics)
+	}
+	result.TopicsRenumbered = len(renumbered)
+
+	usedPostIDs := make(map[uint]bool, len(existingPosts))
+	for _, p := range existingPosts {
+		usedPostIDs[p.ID] = true
+	}
+	copiedPosts := postKeys(existingPosts)
+
+	var keptPosts, renumberedPosts []*models.Post
+	for _, p := range posts {
+		if usedPostIDs[p.ID] {
+			renumberedPosts = append(renumberedPosts, p)
+		} else {
+			keptPosts = append(keptPosts, p)
+		}
+	}
+
+	for _, p := range append(keptPosts, renumberedPosts...) {
+		oldID := p.ID
+		p.Topic = nil
+		if p.TopicID != nil {
+			if newID, ok := topicIDs[*p.TopicID]; ok {
+				p.TopicID = &newID
+			} else {
+				p.TopicID = nil
+			}
+		}
+
+		key := postKey(p)
+		if copiedPosts[key] {
+			result.PostsSkipped++
+			continue
+		}
+		if usedPostIDs[p.ID] {
+			p.ID = 0
+			result.PostsRenumbered++
+		}
+
+		if err := dst.CreatePost(ctx, p); err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("post %d: %w", oldID, err))
+			continue
+		}
+		copiedPosts[key] = true
+		result.PostsCopied++
+	}
+
+	if token, err := src.GetToken(ctx, "linkedin"); err == nil && token != nil && token.AccessToken != "" {
+		if existing, _ := dst.GetToken(ctx, "linkedin"); existing == nil {
+			token.ID = 0
+			if err := dst.SaveToken(ctx, token); err != nil {
+				result.Errors = append(result.Errors, fmt.Errorf("oauth token: %w", err))
+			} else {
+				result.TokenCopied = true
+			}
+		}
+	}
+
+	return result, nil
+}
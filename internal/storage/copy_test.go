@@ -0,0 +1,78 @@
+package storage_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/internal/storage"
+	"github.com/linkedin-agent/internal/storage/sqlite"
+)
+
+func newSQLiteRepo(t *testing.T, name string) *sqlite.Repository {
+	t.Helper()
+
+	repo, err := sqlite.New(filepath.Join(t.TempDir(), name))
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", name, err)
+	}
+	t.Cleanup(func() { repo.Close() })
+
+	if err := repo.Migrate(); err != nil {
+		t.Fatalf("failed to migrate %s: %v", name, err)
+	}
+	return repo
+}
+
+func TestCopyRerunSkipsCopiedPosts(t *testing.T) {
+	ctx := context.Background()
+	src := newSQLiteRepo(t, "src.db")
+	dst := newSQLiteRepo(t, "dst.db")
+
+	topic := &models.Topic{ExternalID: "rss_1", Title: "Topic", Status: models.TopicStatusApproved}
+	if err := src.CreateTopic(ctx, topic); err != nil {
+		t.Fatalf("CreateTopic: %v", err)
+	}
+	for _, p := range []*models.Post{
+		{TopicID: &topic.ID, Content: "published", Status: models.PostStatusPublished, LinkedInPostURN: "urn:li:share:1"},
+		{TopicID: &topic.ID, Content: "draft", Status: models.PostStatusDraft},
+		{Content: "no topic", Status: models.PostStatusDraft},
+	} {
+		if err := src.CreatePost(ctx, p); err != nil {
+			t.Fatalf("CreatePost: %v", err)
+		}
+	}
+
+	// An unrelated post in the destination forces the copied posts to be renumbered
+	if err := dst.CreatePost(ctx, &models.Post{Content: "already there", Status: models.PostStatusDraft}); err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+
+	first, err := storage.Copy(ctx, src, dst)
+	if err != nil {
+		t.Fatalf("first Copy: %v", err)
+	}
+	if first.PostsCopied != 3 || first.PostsSkipped != 0 {
+		t.Errorf("first run: copied %d, skipped %d posts; want 3, 0", first.PostsCopied, first.PostsSkipped)
+	}
+
+	second, err := storage.Copy(ctx, src, dst)
+	if err != nil {
+		t.Fatalf("second Copy: %v", err)
+	}
+	if second.TopicsCopied != 0 || second.TopicsSkipped != 1 {
+		t.Errorf("second run: copied %d, skipped %d topics; want 0, 1", second.TopicsCopied, second.TopicsSkipped)
+	}
+	if second.PostsCopied != 0 || second.PostsSkipped != 3 {
+		t.Errorf("second run: copied %d, skipped %d posts; want 0, 3", second.PostsCopied, second.PostsSkipped)
+	}
+
+	posts, err := dst.ListPosts(ctx, storage.PostFilter{})
+	if err != nil {
+		t.Fatalf("ListPosts: %v", err)
+	}
+	if len(posts) != 4 {
+		t.Errorf("destination has %d posts after re-run, want 4", len(posts))
+	}
+}
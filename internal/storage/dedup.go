@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/linkedin-agent/internal/models"
+)
+
+// postKey identifies a post independently of its ID, so Copy and Import can skip posts
+// already written by an earlier run: the LinkedIn URN once published, otherwise its topic,
+// creation time and content. The post's TopicID must already refer to the destination topic.
+func postKey(p *models.Post) string {
+	if p.LinkedInPostURN != "" {
+		return "urn|" + p.LinkedInPostURN
+	}
+
+	topicID := ""
+	if p.TopicID != nil {
+		topicID = strconv.FormatUint(uint64(*p.TopicID), 10)
+	}
+	// Sheets keeps timestamps to the second
+	createdAt := p.CreatedAt.UTC().Truncate(time.Second).Format(time.RFC3339)
+
+	return "post|" + topicID + "|" + createdAt + "|" + p.Content
+}
+
+// postKeys returns the set of postKey values of posts
+func postKeys(posts []*models.Post) map[string]bool {
+	keys := make(map[string]bool, len(posts))
+	for _, p := range posts {
+		keys[postKey(p)] = true
+	}
+	return keys
+}
@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linkedin-agent/internal/models"
+)
+
+// Export formats supported by WriteTopics and WritePosts
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// topicCSVHeader lists the columns of a topics CSV export
+var topicCSVHeader = []string{
+	"id", "external_id", "title", "description", "url", "source_type", "source_name",
+	"keywords", "ai_score", "ai_analysis", "status", "discovered_at", "updated_at",
+}
+
+// postCSVHeader lists the columns of a posts CSV export
+var postCSVHeader = []string{
+	"id", "topic_id", "post_type", "status", "content", "linkedin_post_urn", "scheduled_for",
+	"published_at", "error_message", "retry_count", "media_type", "media_url", "created_at", "updated_at",
+}
+
+// WriteTopics writes topics to w as a JSON array or as CSV with a header row.
+// JSON keeps every field; CSV leaves out the raw source data.
+func WriteTopics(w io.Writer, format string, topics []*models.Topic) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, topics)
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(topicCSVHeader); err != nil {
+			return fmt.Errorf("failed to write CSV header: %w", err)
+		}
+		for _, t := range topics {
+			record := []string{
+				strconv.FormatUint(uint64(t.ID), 10),
+				t.ExternalID,
+				t.Title,
+				t.Description,
+				t.URL,
+				t.SourceType,
+				t.SourceName,
+				strings.Join(t.Keywords, ","),
+				strconv.FormatFloat(t.AIScore, 'f', 1, 64),
+				t.AIAnalysis,
+				string(t.Status),
+				formatExportTime(&t.DiscoveredAt),
+				formatExportTime(&t.UpdatedAt),
+			}
+			if err := cw.Write(record); err != nil {
+				return fmt.Errorf("failed to write topic %d: %w", t.ID, err)
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		return fmt.Errorf("unsupported export format %q (use json or csv)", format)
+	}
+}
+
+// WritePosts writes posts to w as a JSON array or as CSV with a header row.
+// JSON keeps every field except the joined topic; CSV leaves out the generation metadata.
+func WritePosts(w io.Writer, format string, posts []*models.Post) error {
+	switch format {
+	case FormatJSON:
+		for _, p := range posts {
+			p.Topic = nil
+		}
+		return writeJSON(w, posts)
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(postCSVHeader); err != nil {
+			return fmt.Errorf("failed to write CSV header: %w", err)
+		}
+		for _, p := range posts {
+			topicID := ""
+			if p.TopicID != nil {
+				topicID = strconv.FormatUint(uint64(*p.TopicID), 10)
+			}
+			record := []string{
+				strconv.FormatUint(uint64(p.ID), 10),
+				topicID,
+				string(p.PostType),
+				string(p.Status),
+				p.Content,
+				p.LinkedInPostURN,
+				formatExportTime(p.ScheduledFor),
+				formatExportTime(p.PublishedAt),
+				p.ErrorMessage,
+				strconv.Itoa(p.RetryCount),
+				string(p.MediaType),
+				p.MediaURL,
+				formatExportTime(&p.CreatedAt),
+				formatExportTime(&p.UpdatedAt),
+			}
+			if err := cw.Write(record); err != nil {
+				return fmt.Errorf("failed to write post %d: %w", p.ID, err)
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		return fmt.Errorf("unsupported export format %q (use json or csv)", format)
+	}
+}
+
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+	return nil
+}
+
+// formatExportTime formats t as RFC3339, or "" when it is unset
+func formatExportTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
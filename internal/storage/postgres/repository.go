@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"fmt"
+
+	pgdriver "gorm.io/driver/postgres"
+
+	"github.com/linkedin-agent/internal/storage/sqlite"
+)
+
+// New connects to PostgreSQL. dsn is a libpq connection string such as
+// "host=localhost user=postgres password=secret dbname=linkedin port=5432 sslmode=disable"
+// or a postgres:// URL. The queries are plain GORM, so the SQLite repository is reused
+// with the PostgreSQL dialector.
+func New(dsn string) (*sqlite.Repository, error) {
+	if dsn == "" {
+		return nil, fmt.Errorf("database.dsn is required for postgres")
+	}
+	return sqlite.Open(pgdriver.Open(dsn))
+}
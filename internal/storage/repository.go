@@ -15,9 +15,13 @@ type Repository interface {
 	GetTopicByID(ctx context.Context, id uint) (*models.Topic, error)
 	GetTopicByExternalID(ctx context.Context, externalID string) (*models.Topic, error)
 	ListTopics(ctx context.Context, filter TopicFilter) ([]*models.Topic, error)
-	GetTopTopics(ctx context.Context, limit int, minScore float64) ([]*models.Topic, error)
+	// GetTopTopics returns the highest-scoring topics at or above minScore whose status is one of statuses
+	GetTopTopics(ctx context.Context, limit int, minScore float64, statuses []models.TopicStatus) ([]*models.Topic, error)
 	UpdateTopic(ctx context.Context, topic *models.Topic) error
 	DeleteTopic(ctx context.Context, id uint) error
+	// DeleteTopicsOlderThan deletes topics discovered before cutoff whose status is one of statuses
+	// and that no post references. Returns the number deleted.
+	DeleteTopicsOlderThan(ctx context.Context, cutoff time.Time, statuses []models.TopicStatus) (int, error)
 
 	// Post operations
 	CreatePost(ctx context.Context, post *models.Post) error
@@ -26,6 +30,7 @@ type Repository interface {
 	UpdatePost(ctx context.Context, post *models.Post) error
 	DeletePost(ctx context.Context, id uint) error
 	GetScheduledPosts(ctx context.Context, before time.Time) ([]*models.Post, error)
+	GetRecentHooks(ctx context.Context, n int) ([]string, error) // Hooks of the n most recently created posts
 
 	// OAuth token operations
 	SaveToken(ctx context.Context, token *models.OAuthToken) error
@@ -42,13 +47,23 @@ type Repository interface {
 
 	// Comment operations
 	CreateComment(ctx context.Context, comment *models.Comment) error
-	GetCommentByTargetURN(ctx context.Context, targetURN string) (*models.Comment, error)
+	GetCommentByID(ctx context.Context, id uint) (*models.Comment, error)
+	GetCommentByTargetURN(ctx context.Context, targetURN string) (*models.Comment, error) // nil, nil when the post has no comment
 	ListComments(ctx context.Context, filter CommentFilter) ([]*models.Comment, error)
 	UpdateComment(ctx context.Context, comment *models.Comment) error
-	GetTodayCommentCount(ctx context.Context) (int, error)
-	GetLastCommentTime(ctx context.Context) (*time.Time, error)
+	CountCommentsSince(ctx context.Context, since time.Time) (int, error) // Posted and pending comments created at or after since
+	GetLastPostedComment(ctx context.Context) (*models.Comment, error)
 	GetRecentCommentStyles(ctx context.Context, limit int) ([]string, error)
 
+	// Excluded author operations
+	AddExcludedAuthor(ctx context.Context, author *models.ExcludedAuthor) error
+	ListExcludedAuthors(ctx context.Context) ([]*models.ExcludedAuthor, error)
+	RemoveExcludedAuthor(ctx context.Context, author string) error
+
+	// AI usage operations
+	AddAIUsage(ctx context.Context, usage *models.AIUsage) error         // Adds usage's counts to the totals of its day
+	GetAIUsage(ctx context.Context, day string) (*models.AIUsage, error) // Zero counts for a day without usage
+
 	// Maintenance
 	Close() error
 	Migrate() error
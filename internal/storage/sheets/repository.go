@@ -16,13 +16,28 @@ import (
 	"github.com/linkedin-agent/internal/models"
 	"github.com/linkedin-agent/internal/storage"
 	"github.com/linkedin-agent/pkg/logger"
+	"github.com/linkedin-agent/pkg/sheetrange"
 )
 
 const (
-	topicsSheetName = "Topics"
-	postsSheetName  = "Posts"
+	topicsSheetName          = "Topics"
+	postsSheetName           = "Posts"
+	oauthSheetName           = "OAuth"
+	excludedAuthorsSheetName = "ExcludedAuthors"
+	aiUsageSheetName         = "AIUsage"
 )
 
+// readCacheTTL is how long a full read of the Topics or Posts sheet is reused. Writes made
+// through the repository invalidate it immediately; edits made by hand in the spreadsheet
+// show up once it expires.
+const readCacheTTL = 30 * time.Second
+
+// cachedRows holds the data rows of a sheet (header excluded) as last read from the API
+type cachedRows struct {
+	rows      [][]interface{}
+	fetchedAt time.Time
+}
+
 // Config holds configuration for Sheets repository
 type Config struct {
 	SpreadsheetID      string
@@ -30,14 +45,23 @@ type Config struct {
 	CredentialsFile    string
 }
 
-// Repository implements storage.Repository using Google Sheets
+// Repository implements storage.Repository using Google Sheets.
+//
+// Rows are addressed by the ID in column A, never by a remembered row number: deleting a row
+// shifts every row below it, so updates and deletes look the row up again right before writing
+// and hold rowMu until the write is done. IDs come from counters seeded with max(ID)+1 when the
+// repository is migrated and are never handed out twice by one process, but deleting the
+// highest-numbered rows lets the next process reuse those IDs.
 type Repository struct {
 	service       *sheets.Service
 	spreadsheetID string
 	log           *logger.Logger
 	mu            sync.RWMutex
+	rowMu         sync.Mutex // Held from resolving a row number until the update or delete is sent
 	nextTopicID   uint
 	nextPostID    uint
+	cache         map[string]cachedRows
+	cacheGen      uint64 // Bumped on every invalidation so in-flight reads don't store stale rows
 }
 
 // New creates a new Sheets repository
@@ -59,15 +83,19 @@ func New(cfg Config, log *logger.Logger) (*Repository, error) {
 		return nil, fmt.Errorf("failed to create sheets service: %w", err)
 	}
 
-	repo := &Repository{
+	return newRepository(srv, cfg.SpreadsheetID, log), nil
+}
+
+// newRepository wraps an existing Sheets service
+func newRepository(srv *sheets.Service, spreadsheetID string, log *logger.Logger) *Repository {
+	return &Repository{
 		service:       srv,
-		spreadsheetID: cfg.SpreadsheetID,
+		spreadsheetID: spreadsheetID,
 		log:           log.WithComponent("sheets-repo"),
 		nextTopicID:   1,
 		nextPostID:    1,
+		cache:         make(map[string]cachedRows),
 	}
-
-	return repo, nil
 }
 
 // Migrate creates sheets and headers if they don't exist
@@ -84,6 +112,21 @@ func (r *Repository) Migrate() error {
 		return fmt.Errorf("failed to create Posts sheet: %w", err)
 	}
 
+	// Create OAuth sheet
+	if err := r.ensureSheetExists(ctx, oauthSheetName, tokenHeaders()); err != nil {
+		return fmt.Errorf("failed to create OAuth sheet: %w", err)
+	}
+
+	// Create ExcludedAuthors sheet
+	if err := r.ensureSheetExists(ctx, excludedAuthorsSheetName, excludedAuthorHeaders()); err != nil {
+		return fmt.Errorf("failed to create ExcludedAuthors sheet: %w", err)
+	}
+
+	// Create AIUsage sheet
+	if err := r.ensureSheetExists(ctx, aiUsageSheetName, aiUsageHeaders()); err != nil {
+		return fmt.Errorf("failed to create AIUsage sheet: %w", err)
+	}
+
 	// Initialize next IDs from existing data
 	if err := r.initNextIDs(ctx); err != nil {
 		r.log.Warn().Err(err).Msg("Failed to initialize IDs from existing data")
@@ -102,9 +145,10 @@ func (r *Repository) Close() error {
 
 // CreateTopic creates a new topic in Google Sheets
 func (r *Repository) CreateTopic(ctx context.Context, topic *models.Topic) error {
+	defer r.invalidate(topicsSheetName)
+
 	r.mu.Lock()
-	topic.ID = r.nextTopicID
-	r.nextTopicID++
+	assignID(&topic.ID, &r.nextTopicID)
 	r.mu.Unlock()
 
 	if topic.DiscoveredAt.IsZero() {
@@ -121,14 +165,14 @@ func (r *Repository) CreateTopicsBatch(ctx context.Context, topics []*models.Top
 	if len(topics) == 0 {
 		return 0, nil
 	}
+	defer r.invalidate(topicsSheetName)
 
 	// Assign IDs and prepare rows
 	r.mu.Lock()
 	rows := make([][]interface{}, 0, len(topics))
 	now := time.Now()
 	for _, topic := range topics {
-		topic.ID = r.nextTopicID
-		r.nextTopicID++
+		assignID(&topic.ID, &r.nextTopicID)
 		if topic.DiscoveredAt.IsZero() {
 			topic.DiscoveredAt = now
 		}
@@ -138,17 +182,7 @@ func (r *Repository) CreateTopicsBatch(ctx context.Context, topics []*models.Top
 	r.mu.Unlock()
 
 	// Batch append all topics in a single API call
-	appendRange := fmt.Sprintf("%s!A:Z", topicsSheetName)
-	valueRange := &sheets.ValueRange{
-		Values: rows,
-	}
-
-	_, err := r.service.Spreadsheets.Values.Append(r.spreadsheetID, appendRange, valueRange).
-		ValueInputOption("RAW").
-		InsertDataOption("INSERT_ROWS").
-		Context(ctx).
-		Do()
-	if err != nil {
+	if err := r.appendRows(ctx, topicsSheetName, rows); err != nil {
 		return 0, fmt.Errorf("failed to batch append topics: %w", err)
 	}
 
@@ -225,16 +259,21 @@ func (r *Repository) ListTopics(ctx context.Context, filter storage.TopicFilter)
 }
 
 // GetTopTopics returns top-scoring topics
-func (r *Repository) GetTopTopics(ctx context.Context, limit int, minScore float64) ([]*models.Topic, error) {
+func (r *Repository) GetTopTopics(ctx context.Context, limit int, minScore float64, statuses []models.TopicStatus) ([]*models.Topic, error) {
 	topics, err := r.readAllTopics(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter by score and pending status
+	wanted := make(map[models.TopicStatus]bool, len(statuses))
+	for _, status := range statuses {
+		wanted[status] = true
+	}
+
+	// Filter by score and requested statuses
 	var filtered []*models.Topic
 	for _, t := range topics {
-		if t.AIScore >= minScore && t.Status == models.TopicStatusPending {
+		if t.AIScore >= minScore && wanted[t.Status] {
 			filtered = append(filtered, t)
 		}
 	}
@@ -254,6 +293,9 @@ func (r *Repository) GetTopTopics(ctx context.Context, limit int, minScore float
 
 // UpdateTopic updates an existing topic
 func (r *Repository) UpdateTopic(ctx context.Context, topic *models.Topic) error {
+	defer r.invalidate(topicsSheetName)
+	r.rowMu.Lock()
+	defer r.rowMu.Unlock()
 	topic.UpdatedAt = time.Now()
 
 	rowNum, err := r.findRowByID(ctx, topicsSheetName, topic.ID)
@@ -267,6 +309,10 @@ func (r *Repository) UpdateTopic(ctx context.Context, topic *models.Topic) error
 
 // DeleteTopic deletes a topic by ID
 func (r *Repository) DeleteTopic(ctx context.Context, id uint) error {
+	defer r.invalidate(topicsSheetName)
+	r.rowMu.Lock()
+	defer r.rowMu.Unlock()
+
 	rowNum, err := r.findRowByID(ctx, topicsSheetName, id)
 	if err != nil {
 		return err
@@ -274,13 +320,61 @@ func (r *Repository) DeleteTopic(ctx context.Context, id uint) error {
 	return r.deleteRow(ctx, topicsSheetName, rowNum)
 }
 
+// DeleteTopicsOlderThan deletes topics discovered before cutoff whose status is one of statuses
+// and that no post references, in a single batch update
+func (r *Repository) DeleteTopicsOlderThan(ctx context.Context, cutoff time.Time, statuses []models.TopicStatus) (int, error) {
+	defer r.invalidate(topicsSheetName)
+	r.rowMu.Lock()
+	defer r.rowMu.Unlock()
+
+	// Row numbers must match the sheet as it is now, so skip the read cache
+	rows, err := r.fetchRows(ctx, topicsSheetName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read topics: %w", err)
+	}
+
+	posts, err := r.readAllPosts(ctx)
+	if err != nil {
+		return 0, err
+	}
+	referenced := make(map[uint]bool, len(posts))
+	for _, p := range posts {
+		if p.TopicID != nil {
+			referenced[*p.TopicID] = true
+		}
+	}
+
+	wanted := make(map[models.TopicStatus]bool, len(statuses))
+	for _, status := range statuses {
+		wanted[status] = true
+	}
+
+	var rowNums []int
+	for i, row := range rows {
+		t := rowToTopic(row)
+		if t == nil || !wanted[t.Status] || referenced[t.ID] || !t.DiscoveredAt.Before(cutoff) {
+			continue
+		}
+		rowNums = append(rowNums, i+2) // Data starts on row 2
+	}
+
+	if len(rowNums) == 0 {
+		return 0, nil
+	}
+	if err := r.deleteRows(ctx, topicsSheetName, rowNums); err != nil {
+		return 0, err
+	}
+	return len(rowNums), nil
+}
+
 // ============ POST OPERATIONS ============
 
 // CreatePost creates a new post
 func (r *Repository) CreatePost(ctx context.Context, post *models.Post) error {
+	defer r.invalidate(postsSheetName)
+
 	r.mu.Lock()
-	post.ID = r.nextPostID
-	r.nextPostID++
+	assignID(&post.ID, &r.nextPostID)
 	r.mu.Unlock()
 
 	if post.CreatedAt.IsZero() {
@@ -347,6 +441,9 @@ func (r *Repository) ListPosts(ctx context.Context, filter storage.PostFilter) (
 
 // UpdatePost updates an existing post
 func (r *Repository) UpdatePost(ctx context.Context, post *models.Post) error {
+	defer r.invalidate(postsSheetName)
+	r.rowMu.Lock()
+	defer r.rowMu.Unlock()
 	post.UpdatedAt = time.Now()
 
 	rowNum, err := r.findRowByID(ctx, postsSheetName, post.ID)
@@ -360,6 +457,10 @@ func (r *Repository) UpdatePost(ctx context.Context, post *models.Post) error {
 
 // DeletePost deletes a post by ID
 func (r *Repository) DeletePost(ctx context.Context, id uint) error {
+	defer r.invalidate(postsSheetName)
+	r.rowMu.Lock()
+	defer r.rowMu.Unlock()
+
 	rowNum, err := r.findRowByID(ctx, postsSheetName, id)
 	if err != nil {
 		return err
@@ -389,22 +490,96 @@ func (r *Repository) GetScheduledPosts(ctx context.Context, before time.Time) ([
 	return scheduled, nil
 }
 
-// ============ OAUTH TOKEN OPERATIONS (NOT SUPPORTED - USE ENV VARS) ============
+// GetRecentHooks returns the hooks of the n most recently created posts
+func (r *Repository) GetRecentHooks(ctx context.Context, n int) ([]string, error) {
+	posts, err := r.ListPosts(ctx, storage.PostFilter{OrderBy: "created_at", OrderDesc: true, Limit: n})
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := make([]string, 0, len(posts))
+	for _, p := range posts {
+		if hook, ok := p.AIMetadata["hook"].(string); ok && hook != "" {
+			hooks = append(hooks, hook)
+		}
+	}
+	return hooks, nil
+}
 
-// SaveToken is not supported - use environment variables
+// ============ OAUTH TOKEN OPERATIONS ============
+// Tokens are stored in plain text in the OAuth sheet, one row per provider:
+// keep the spreadsheet private to the service account.
+
+// SaveToken creates or replaces the token row for the token's provider
 func (r *Repository) SaveToken(ctx context.Context, token *models.OAuthToken) error {
-	r.log.Warn().Msg("SaveToken called but Sheets repo uses env vars for OAuth - token not persisted")
-	return nil
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	token.UpdatedAt = now
+
+	rowNum, err := r.findTokenRow(ctx, token.Provider)
+	if err != nil {
+		return err
+	}
+
+	if rowNum == 0 {
+		if token.CreatedAt.IsZero() {
+			token.CreatedAt = now
+		}
+		return r.appendRow(ctx, oauthSheetName, tokenToRow(token))
+	}
+
+	return r.updateRow(ctx, oauthSheetName, rowNum, tokenToRow(token))
 }
 
-// GetToken is not supported - use environment variables
+// GetToken retrieves the token for a provider
 func (r *Repository) GetToken(ctx context.Context, provider string) (*models.OAuthToken, error) {
-	return nil, fmt.Errorf("OAuth tokens are managed via environment variables, not Sheets storage")
+	readRange := sheetrange.Data(oauthSheetName, sheetWidth(oauthSheetName))
+	resp, err := r.service.Spreadsheets.Values.Get(r.spreadsheetID, readRange).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tokens: %w", err)
+	}
+
+	for _, row := range resp.Values {
+		if token := rowToToken(row); token != nil && token.Provider == provider {
+			return token, nil
+		}
+	}
+
+	return nil, fmt.Errorf("token for %s not found", provider)
 }
 
-// DeleteToken is not supported
+// DeleteToken removes the token row for a provider
 func (r *Repository) DeleteToken(ctx context.Context, provider string) error {
-	return nil
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rowNum, err := r.findTokenRow(ctx, provider)
+	if err != nil || rowNum == 0 {
+		return err
+	}
+	return r.deleteRow(ctx, oauthSheetName, rowNum)
+}
+
+// findTokenRow returns the 1-based row of a provider's token, or 0 if there is none
+func (r *Repository) findTokenRow(ctx context.Context, provider string) (int, error) {
+	readRange := fmt.Sprintf("%s!A:A", oauthSheetName)
+	resp, err := r.service.Spreadsheets.Values.Get(r.spreadsheetID, readRange).Context(ctx).Do()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read token providers: %w", err)
+	}
+
+	for i, row := range resp.Values {
+		if i == 0 {
+			continue // Skip header
+		}
+		if len(row) > 0 && fmt.Sprintf("%v", row[0]) == provider {
+			return i + 1, nil
+		}
+	}
+
+	return 0, nil
 }
 
 // ============ SOURCE CONFIG OPERATIONS (NOT SUPPORTED - USE CONFIG FILE) ============
@@ -470,7 +645,7 @@ func (r *Repository) ensureSheetExists(ctx context.Context, sheetName string, he
 	}
 
 	// Check if headers exist
-	readRange := fmt.Sprintf("%s!A1:%s1", sheetName, columnLetter(len(headers)))
+	readRange := sheetrange.Header(sheetName, len(headers))
 	resp, err := r.service.Spreadsheets.Values.Get(r.spreadsheetID, readRange).Context(ctx).Do()
 	if err != nil {
 		return fmt.Errorf("failed to read sheet: %w", err)
@@ -526,10 +701,31 @@ func (r *Repository) initNextIDs(ctx context.Context) error {
 	return nil
 }
 
+// assignID gives a new record the next free ID, or keeps an ID set by the caller (as when
+// copying data from another backend) and moves the counter past it. Callers hold mu.
+func assignID(id *uint, next *uint) {
+	if *id == 0 {
+		*id = *next
+		*next++
+		return
+	}
+	if *id >= *next {
+		*next = *id + 1
+	}
+}
+
 func (r *Repository) appendRow(ctx context.Context, sheetName string, row []interface{}) error {
-	appendRange := fmt.Sprintf("%s!A:Z", sheetName)
+	if err := r.appendRows(ctx, sheetName, [][]interface{}{row}); err != nil {
+		return fmt.Errorf("failed to append row: %w", err)
+	}
+	return nil
+}
+
+// appendRows appends all rows to the end of a sheet in a single API call
+func (r *Repository) appendRows(ctx context.Context, sheetName string, rows [][]interface{}) error {
+	appendRange := sheetrange.Columns(sheetName, sheetWidth(sheetName))
 	valueRange := &sheets.ValueRange{
-		Values: [][]interface{}{row},
+		Values: rows,
 	}
 
 	_, err := r.service.Spreadsheets.Values.Append(r.spreadsheetID, appendRange, valueRange).
@@ -537,15 +733,11 @@ func (r *Repository) appendRow(ctx context.Context, sheetName string, row []inte
 		InsertDataOption("INSERT_ROWS").
 		Context(ctx).
 		Do()
-	if err != nil {
-		return fmt.Errorf("failed to append row: %w", err)
-	}
-
-	return nil
+	return err
 }
 
 func (r *Repository) updateRow(ctx context.Context, sheetName string, rowNum int, row []interface{}) error {
-	updateRange := fmt.Sprintf("%s!A%d:%s%d", sheetName, rowNum, columnLetter(len(row)), rowNum)
+	updateRange := sheetrange.Row(sheetName, rowNum, len(row))
 	valueRange := &sheets.ValueRange{
 		Values: [][]interface{}{row},
 	}
@@ -562,6 +754,11 @@ func (r *Repository) updateRow(ctx context.Context, sheetName string, rowNum int
 }
 
 func (r *Repository) deleteRow(ctx context.Context, sheetName string, rowNum int) error {
+	return r.deleteRows(ctx, sheetName, []int{rowNum})
+}
+
+// deleteRows deletes the given 1-indexed rows in one batch update
+func (r *Repository) deleteRows(ctx context.Context, sheetName string, rowNums []int) error {
 	// Get sheet ID
 	spreadsheet, err := r.service.Spreadsheets.Get(r.spreadsheetID).Context(ctx).Do()
 	if err != nil {
@@ -576,27 +773,33 @@ func (r *Repository) deleteRow(ctx context.Context, sheetName string, rowNum int
 		}
 	}
 
-	req := &sheets.BatchUpdateSpreadsheetRequest{
-		Requests: []*sheets.Request{
-			{
-				DeleteDimension: &sheets.DeleteDimensionRequest{
-					Range: &sheets.DimensionRange{
-						SheetId:    sheetID,
-						Dimension:  "ROWS",
-						StartIndex: int64(rowNum - 1),
-						EndIndex:   int64(rowNum),
-					},
+	// Delete bottom-up so earlier deletions don't shift the remaining row numbers
+	sorted := append([]int(nil), rowNums...)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+
+	req := &sheets.BatchUpdateSpreadsheetRequest{}
+	for _, rowNum := range sorted {
+		req.Requests = append(req.Requests, &sheets.Request{
+			DeleteDimension: &sheets.DeleteDimensionRequest{
+				Range: &sheets.DimensionRange{
+					SheetId:    sheetID,
+					Dimension:  "ROWS",
+					StartIndex: int64(rowNum - 1),
+					EndIndex:   int64(rowNum),
 				},
 			},
-		},
+		})
 	}
 
 	_, err = r.service.Spreadsheets.BatchUpdate(r.spreadsheetID, req).Context(ctx).Do()
 	return err
 }
 
+// findRowByID returns the 1-indexed row holding id. It always reads the sheet instead of
+// using the read cache so the row number is current; callers must hold rowMu until they
+// have written to the row.
 func (r *Repository) findRowByID(ctx context.Context, sheetName string, id uint) (int, error) {
-	readRange := fmt.Sprintf("%s!A:A", sheetName)
+	readRange := fmt.Sprintf("%s!A2:A", sheetName)
 	resp, err := r.service.Spreadsheets.Values.Get(r.spreadsheetID, readRange).Context(ctx).Do()
 	if err != nil {
 		return 0, fmt.Errorf("failed to read IDs: %w", err)
@@ -604,26 +807,65 @@ func (r *Repository) findRowByID(ctx context.Context, sheetName string, id uint)
 
 	idStr := strconv.FormatUint(uint64(id), 10)
 	for i, row := range resp.Values {
-		if i == 0 {
-			continue // Skip header
-		}
 		if len(row) > 0 && fmt.Sprintf("%v", row[0]) == idStr {
-			return i + 1, nil // 1-indexed row number
+			return i + 2, nil // 1-indexed row number, data starts on row 2
 		}
 	}
 
 	return 0, fmt.Errorf("row with ID %d not found in %s", id, sheetName)
 }
 
-func (r *Repository) readAllTopics(ctx context.Context) ([]*models.Topic, error) {
-	readRange := fmt.Sprintf("%s!A2:Z", topicsSheetName)
+// readRows returns the data rows of a sheet, reusing a read from the last readCacheTTL.
+// Rows are parsed into fresh models by the callers, so cached data is never mutated.
+func (r *Repository) readRows(ctx context.Context, sheetName string) ([][]interface{}, error) {
+	r.mu.RLock()
+	cached, ok := r.cache[sheetName]
+	gen := r.cacheGen
+	r.mu.RUnlock()
+	if ok && time.Since(cached.fetchedAt) < readCacheTTL {
+		return cached.rows, nil
+	}
+
+	rows, err := r.fetchRows(ctx, sheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	if r.cacheGen == gen {
+		r.cache[sheetName] = cachedRows{rows: rows, fetchedAt: time.Now()}
+	}
+	r.mu.Unlock()
+
+	return rows, nil
+}
+
+// fetchRows reads the data rows of a sheet from the API, bypassing the cache
+func (r *Repository) fetchRows(ctx context.Context, sheetName string) ([][]interface{}, error) {
+	readRange := sheetrange.Data(sheetName, sheetWidth(sheetName))
 	resp, err := r.service.Spreadsheets.Values.Get(r.spreadsheetID, readRange).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Values, nil
+}
+
+// invalidate drops the cached rows of a sheet after a write
+func (r *Repository) invalidate(sheetName string) {
+	r.mu.Lock()
+	delete(r.cache, sheetName)
+	r.cacheGen++
+	r.mu.Unlock()
+}
+
+func (r *Repository) readAllTopics(ctx context.Context) ([]*models.Topic, error) {
+	rows, err := r.readRows(ctx, topicsSheetName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read topics: %w", err)
 	}
 
 	var topics []*models.Topic
-	for _, row := range resp.Values {
+	for _, row := range rows {
 		topic := rowToTopic(row)
 		if topic != nil {
 			topics = append(topics, topic)
@@ -634,14 +876,13 @@ func (r *Repository) readAllTopics(ctx context.Context) ([]*models.Topic, error)
 }
 
 func (r *Repository) readAllPosts(ctx context.Context) ([]*models.Post, error) {
-	readRange := fmt.Sprintf("%s!A2:Z", postsSheetName)
-	resp, err := r.service.Spreadsheets.Values.Get(r.spreadsheetID, readRange).Context(ctx).Do()
+	rows, err := r.readRows(ctx, postsSheetName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read posts: %w", err)
 	}
 
 	var posts []*models.Post
-	for _, row := range resp.Values {
+	for _, row := range rows {
 		post := rowToPost(row)
 		if post != nil {
 			posts = append(posts, post)
@@ -651,15 +892,22 @@ func (r *Repository) readAllPosts(ctx context.Context) ([]*models.Post, error) {
 	return posts, nil
 }
 
-// columnLetter converts a 1-based column index to Excel-style letter (1=A, 26=Z, 27=AA)
-func columnLetter(n int) string {
-	result := ""
-	for n > 0 {
-		n-- // Adjust for 0-based indexing
-		result = string(rune('A'+n%26)) + result
-		n /= 26
-	}
-	return result
+// sheetWidth returns the number of columns of a sheet, taken from its headers so ranges
+// grow with the schema instead of stopping at a hardcoded last column
+func sheetWidth(sheetName string) int {
+	switch sheetName {
+	case topicsSheetName:
+		return len(topicHeaders())
+	case postsSheetName:
+		return len(postHeaders())
+	case oauthSheetName:
+		return len(tokenHeaders())
+	case excludedAuthorsSheetName:
+		return len(excludedAuthorHeaders())
+	case aiUsageSheetName:
+		return len(aiUsageHeaders())
+	}
+	return 26
 }
 
 func sortTopics(topics []*models.Topic, orderBy string, desc bool) {
@@ -916,6 +1164,45 @@ func rowToPost(row []interface{}) *models.Post {
 	return p
 }
 
+// ============ OAUTH TOKEN SERIALIZATION ============
+
+func tokenHeaders() []string {
+	return []string{
+		"Provider", "AccessToken", "RefreshToken", "TokenType",
+		"Scope", "ExpiresAt", "CreatedAt", "UpdatedAt",
+	}
+}
+
+func tokenToRow(t *models.OAuthToken) []interface{} {
+	return []interface{}{
+		t.Provider,
+		t.AccessToken,
+		t.RefreshToken,
+		t.TokenType,
+		t.Scope,
+		t.ExpiresAt.Format(time.RFC3339),
+		t.CreatedAt.Format(time.RFC3339),
+		t.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func rowToToken(row []interface{}) *models.OAuthToken {
+	if len(row) < 6 {
+		return nil
+	}
+
+	return &models.OAuthToken{
+		Provider:     parseString(row, 0),
+		AccessToken:  parseString(row, 1),
+		RefreshToken: parseString(row, 2),
+		TokenType:    parseString(row, 3),
+		Scope:        parseString(row, 4),
+		ExpiresAt:    parseTime(row, 5),
+		CreatedAt:    parseTime(row, 6),
+		UpdatedAt:    parseTime(row, 7),
+	}
+}
+
 // ============ PARSING HELPERS ============
 
 func parseString(row []interface{}, idx int) string {
@@ -968,6 +1255,10 @@ func (r *Repository) CreateComment(ctx context.Context, comment *models.Comment)
 	return fmt.Errorf("comment operations not supported in Google Sheets storage")
 }
 
+func (r *Repository) GetCommentByID(ctx context.Context, id uint) (*models.Comment, error) {
+	return nil, fmt.Errorf("comment operations not supported in Google Sheets storage")
+}
+
 func (r *Repository) GetCommentByTargetURN(ctx context.Context, targetURN string) (*models.Comment, error) {
 	return nil, fmt.Errorf("comment operations not supported in Google Sheets storage")
 }
@@ -980,14 +1271,203 @@ func (r *Repository) UpdateComment(ctx context.Context, comment *models.Comment)
 	return fmt.Errorf("comment operations not supported in Google Sheets storage")
 }
 
-func (r *Repository) GetTodayCommentCount(ctx context.Context) (int, error) {
+func (r *Repository) CountCommentsSince(ctx context.Context, since time.Time) (int, error) {
 	return 0, fmt.Errorf("comment operations not supported in Google Sheets storage")
 }
 
-func (r *Repository) GetLastCommentTime(ctx context.Context) (*time.Time, error) {
+func (r *Repository) GetLastPostedComment(ctx context.Context) (*models.Comment, error) {
 	return nil, fmt.Errorf("comment operations not supported in Google Sheets storage")
 }
 
 func (r *Repository) GetRecentCommentStyles(ctx context.Context, limit int) ([]string, error) {
 	return nil, fmt.Errorf("comment operations not supported in Google Sheets storage")
 }
+
+// ============ EXCLUDED AUTHOR OPERATIONS ============
+// One row per author, keyed by the author in column A like the OAuth sheet's providers
+
+// AddExcludedAuthor excludes an author, updating the reason if they are already excluded
+func (r *Repository) AddExcludedAuthor(ctx context.Context, author *models.ExcludedAuthor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rowNum, err := r.findExcludedAuthorRow(ctx, author.Author)
+	if err != nil {
+		return err
+	}
+
+	if rowNum == 0 {
+		if author.CreatedAt.IsZero() {
+			author.CreatedAt = time.Now()
+		}
+		return r.appendRow(ctx, excludedAuthorsSheetName, excludedAuthorToRow(author))
+	}
+
+	// Keep the original CreatedAt, so "added" still shows when the author was first excluded
+	readRange := sheetrange.Row(excludedAuthorsSheetName, rowNum, sheetWidth(excludedAuthorsSheetName))
+	resp, err := r.service.Spreadsheets.Values.Get(r.spreadsheetID, readRange).Context(ctx).Do()
+	if err == nil && len(resp.Values) > 0 {
+		if existing := rowToExcludedAuthor(resp.Values[0]); existing != nil {
+			author.CreatedAt = existing.CreatedAt
+		}
+	}
+	return r.updateRow(ctx, excludedAuthorsSheetName, rowNum, excludedAuthorToRow(author))
+}
+
+// ListExcludedAuthors returns the excluded authors, most recently added first
+func (r *Repository) ListExcludedAuthors(ctx context.Context) ([]*models.ExcludedAuthor, error) {
+	readRange := sheetrange.Data(excludedAuthorsSheetName, sheetWidth(excludedAuthorsSheetName))
+	resp, err := r.service.Spreadsheets.Values.Get(r.spreadsheetID, readRange).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read excluded authors: %w", err)
+	}
+
+	var authors []*models.ExcludedAuthor
+	for _, row := range resp.Values {
+		if author := rowToExcludedAuthor(row); author != nil {
+			authors = append(authors, author)
+		}
+	}
+	sort.SliceStable(authors, func(i, j int) bool {
+		return authors[i].CreatedAt.After(authors[j].CreatedAt)
+	})
+
+	return authors, nil
+}
+
+// RemoveExcludedAuthor deletes the author's row; removing an author who isn't excluded is a no-op
+func (r *Repository) RemoveExcludedAuthor(ctx context.Context, author string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rowNum, err := r.findExcludedAuthorRow(ctx, author)
+	if err != nil || rowNum == 0 {
+		return err
+	}
+	return r.deleteRow(ctx, excludedAuthorsSheetName, rowNum)
+}
+
+// findExcludedAuthorRow returns the 1-based row of an excluded author, or 0 if there is none
+func (r *Repository) findExcludedAuthorRow(ctx context.Context, author string) (int, error) {
+	readRange := fmt.Sprintf("%s!A:A", excludedAuthorsSheetName)
+	resp, err := r.service.Spreadsheets.Values.Get(r.spreadsheetID, readRange).Context(ctx).Do()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read excluded authors: %w", err)
+	}
+
+	for i, row := range resp.Values {
+		if i == 0 {
+			continue // Skip header
+		}
+		if len(row) > 0 && fmt.Sprintf("%v", row[0]) == author {
+			return i + 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+func excludedAuthorHeaders() []string {
+	return []string{"Author", "Reason", "CreatedAt"}
+}
+
+func excludedAuthorToRow(a *models.ExcludedAuthor) []interface{} {
+	return []interface{}{
+		a.Author,
+		a.Reason,
+		a.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func rowToExcludedAuthor(row []interface{}) *models.ExcludedAuthor {
+	if len(row) < 1 || parseString(row, 0) == "" {
+		return nil
+	}
+
+	return &models.ExcludedAuthor{
+		Author:    parseString(row, 0),
+		Reason:    parseString(row, 1),
+		CreatedAt: parseTime(row, 2),
+	}
+}
+
+// ============ AI USAGE OPERATIONS ============
+// One row per day, keyed by the day in column A
+
+// AddAIUsage adds usage's counts to its day's row, creating the row on the day's first call
+func (r *Repository) AddAIUsage(ctx context.Context, usage *models.AIUsage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rowNum, existing, err := r.findAIUsageRow(ctx, usage.Day)
+	if err != nil {
+		return err
+	}
+
+	total := *usage
+	total.UpdatedAt = time.Now()
+	if rowNum == 0 {
+		return r.appendRow(ctx, aiUsageSheetName, aiUsageToRow(&total))
+	}
+	total.Requests += existing.Requests
+	total.InputTokens += existing.InputTokens
+	total.OutputTokens += existing.OutputTokens
+	return r.updateRow(ctx, aiUsageSheetName, rowNum, aiUsageToRow(&total))
+}
+
+// GetAIUsage returns a day's usage, with zero counts if nothing was used that day
+func (r *Repository) GetAIUsage(ctx context.Context, day string) (*models.AIUsage, error) {
+	_, usage, err := r.findAIUsageRow(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+	if usage == nil {
+		return &models.AIUsage{Day: day}, nil
+	}
+	return usage, nil
+}
+
+// findAIUsageRow returns the 1-based row and usage of a day, or 0 and nil if there is none
+func (r *Repository) findAIUsageRow(ctx context.Context, day string) (int, *models.AIUsage, error) {
+	readRange := sheetrange.Data(aiUsageSheetName, sheetWidth(aiUsageSheetName))
+	resp, err := r.service.Spreadsheets.Values.Get(r.spreadsheetID, readRange).Context(ctx).Do()
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read AI usage: %w", err)
+	}
+
+	for i, row := range resp.Values {
+		if usage := rowToAIUsage(row); usage != nil && usage.Day == day {
+			return i + 2, usage, nil // Data starts below the header
+		}
+	}
+
+	return 0, nil, nil
+}
+
+func aiUsageHeaders() []string {
+	return []string{"Day", "Requests", "InputTokens", "OutputTokens", "UpdatedAt"}
+}
+
+func aiUsageToRow(u *models.AIUsage) []interface{} {
+	return []interface{}{
+		u.Day,
+		u.Requests,
+		u.InputTokens,
+		u.OutputTokens,
+		u.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func rowToAIUsage(row []interface{}) *models.AIUsage {
+	if len(row) < 1 || parseString(row, 0) == "" {
+		return nil
+	}
+
+	return &models.AIUsage{
+		Day:          parseString(row, 0),
+		Requests:     parseInt(row, 1),
+		InputTokens:  int64(parseInt(row, 2)),
+		OutputTokens: int64(parseInt(row, 3)),
+		UpdatedAt:    parseTime(row, 4),
+	}
+}
@@ -0,0 +1,290 @@
+package sheets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/sheets/v4"
+
+	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/internal/storage"
+	"github.com/linkedin-agent/pkg/logger"
+)
+
+// fakeSheets is an in-memory stand-in for the Sheets API covering the calls the repository
+// makes: values get/update/append, spreadsheet get and row deletes. Each call takes delay,
+// so concurrent callers interleave the way they would against the real API.
+type fakeSheets struct {
+	mu     sync.Mutex
+	sheets map[string][][]string // Sheet name -> rows, header first
+	delay  time.Duration
+}
+
+var a1Pattern = regexp.MustCompile(`^(.+)!([A-Z]+)(\d*)(?::([A-Z]+)(\d*))?$`)
+
+// parseRange splits an A1 range into the sheet name, 0-based first/last column and row
+// (last row -1 = open-ended)
+func parseRange(a1 string) (sheet string, col1, row1, col2, row2 int) {
+	m := a1Pattern.FindStringSubmatch(a1)
+	if m == nil {
+		return a1, 0, 0, -1, -1
+	}
+	col1, col2 = columnIndex(m[2]), columnIndex(m[2])
+	row1, row2 = 0, -1
+	if m[3] != "" {
+		row1, _ = strconv.Atoi(m[3])
+		row1--
+		row2 = row1
+	}
+	if m[4] != "" {
+		col2 = columnIndex(m[4])
+		row2 = -1
+		if m[5] != "" {
+			row2, _ = strconv.Atoi(m[5])
+			row2--
+		}
+	}
+	return m[1], col1, row1, col2, row2
+}
+
+func columnIndex(letters string) int {
+	n := 0
+	for _, c := range letters {
+		n = n*26 + int(c-'A'+1)
+	}
+	return n - 1
+}
+
+func (f *fakeSheets) serve(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		delay := f.delay
+		f.mu.Unlock()
+		time.Sleep(delay)
+
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		path := strings.TrimPrefix(r.URL.Path, "/v4/spreadsheets/test-sheet")
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case path == "" && r.Method == http.MethodGet:
+			var props []map[string]interface{}
+			id := 0
+			for name := range f.sheets {
+				id++
+				props = append(props, map[string]interface{}{"properties": map[string]interface{}{"title": name, "sheetId": id}})
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"sheets": props})
+
+		case path == ":batchUpdate":
+			var req sheets.BatchUpdateSpreadsheetRequest
+			json.NewDecoder(r.Body).Decode(&req)
+			// Only row deletes of the Posts sheet are needed here
+			for _, rq := range req.Requests {
+				if d := rq.DeleteDimension; d != nil {
+					rows := f.sheets["Posts"]
+					f.sheets["Posts"] = append(rows[:d.Range.StartIndex:d.Range.StartIndex], rows[d.Range.EndIndex:]...)
+				}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{})
+
+		case strings.HasPrefix(path, "/values/") && strings.HasSuffix(path, ":append"):
+			sheet, _, _, _, _ := parseRange(strings.TrimSuffix(strings.TrimPrefix(path, "/values/"), ":append"))
+			for _, row := range decodeValues(t, r) {
+				f.sheets[sheet] = append(f.sheets[sheet], row)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{})
+
+		case strings.HasPrefix(path, "/values/") && r.Method == http.MethodPut:
+			sheet, _, row1, _, _ := parseRange(strings.TrimPrefix(path, "/values/"))
+			for i, row := range decodeValues(t, r) {
+				for len(f.sheets[sheet]) <= row1+i {
+					f.sheets[sheet] = append(f.sheets[sheet], nil)
+				}
+				f.sheets[sheet][row1+i] = row
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{})
+
+		case strings.HasPrefix(path, "/values/") && r.Method == http.MethodGet:
+			sheet, col1, row1, col2, row2 := parseRange(strings.TrimPrefix(path, "/values/"))
+			var values [][]string
+			for i, row := range f.sheets[sheet] {
+				if i < row1 || (row2 >= 0 && i > row2) {
+					continue
+				}
+				var cells []string
+				for c := col1; c < len(row) && (col2 < 0 || c <= col2); c++ {
+					cells = append(cells, row[c])
+				}
+				values = append(values, cells)
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"values": values})
+
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// decodeValues reads a ValueRange body, keeping every cell as the string the API would return
+func decodeValues(t *testing.T, r *http.Request) [][]string {
+	var body struct {
+		Values [][]json.RawMessage `json:"values"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		t.Errorf("failed to decode values: %v", err)
+	}
+
+	rows := make([][]string, len(body.Values))
+	for i, row := range body.Values {
+		for _, cell := range row {
+			var s string
+			if err := json.Unmarshal(cell, &s); err != nil {
+				s = string(cell)
+			}
+			rows[i] = append(rows[i], s)
+		}
+	}
+	return rows
+}
+
+// newTestRepository returns a migrated repository backed by a fakeSheets with n posts
+func newTestRepository(t *testing.T, n int) (*Repository, *fakeSheets) {
+	t.Helper()
+
+	fake := &fakeSheets{sheets: make(map[string][][]string)}
+	for _, name := range []string{topicsSheetName, postsSheetName, oauthSheetName, excludedAuthorsSheetName, aiUsageSheetName} {
+		fake.sheets[name] = nil
+	}
+	server := fake.serve(t)
+
+	srv, err := sheets.NewService(context.Background(), option.WithEndpoint(server.URL+"/"), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("failed to create sheets service: %v", err)
+	}
+	repo := newRepository(srv, "test-sheet", logger.New(logger.Config{Level: "error"}))
+	if err := repo.Migrate(); err != nil {
+		t.Fatalf("Migrate: %v", err)
+	}
+
+	for i := 1; i <= n; i++ {
+		if err := repo.CreatePost(context.Background(), &models.Post{Content: fmt.Sprintf("post %d", i), Status: models.PostStatusDraft}); err != nil {
+			t.Fatalf("CreatePost: %v", err)
+		}
+	}
+	fake.mu.Lock()
+	fake.delay = 2 * time.Millisecond
+	fake.mu.Unlock()
+
+	return repo, fake
+}
+
+// checkRows fails unless every post row's content still belongs to its ID
+func checkRows(t *testing.T, fake *fakeSheets, suffix string) {
+	t.Helper()
+
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+
+	for _, row := range fake.sheets[postsSheetName][1:] {
+		if len(row) < 3 || row[0] == "" {
+			continue // Hand-inserted or blank row
+		}
+		if want := "post " + row[0] + suffix; row[2] != want {
+			t.Errorf("row for ID %s has content %q, want %q", row[0], row[2], want)
+		}
+	}
+}
+
+func TestConcurrentUpdatesAndDeletesHitTheirOwnRows(t *testing.T) {
+	ctx := context.Background()
+	repo, fake := newTestRepository(t, 10)
+
+	// Deleting the odd posts shifts the rows of the even ones while they are being updated
+	var wg sync.WaitGroup
+	for id := uint(1); id <= 10; id++ {
+		wg.Add(1)
+		go func(id uint) {
+			defer wg.Done()
+			if id%2 == 1 {
+				if err := repo.DeletePost(ctx, id); err != nil {
+					t.Errorf("DeletePost(%d): %v", id, err)
+				}
+				return
+			}
+			post := &models.Post{ID: id, Content: fmt.Sprintf("post %d updated", id), Status: models.PostStatusScheduled}
+			if err := repo.UpdatePost(ctx, post); err != nil {
+				t.Errorf("UpdatePost(%d): %v", id, err)
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	checkRows(t, fake, " updated")
+	posts, err := repo.ListPosts(ctx, storage.PostFilter{})
+	if err != nil {
+		t.Fatalf("ListPosts: %v", err)
+	}
+	if len(posts) != 5 {
+		t.Errorf("got %d posts, want the 5 even ones", len(posts))
+	}
+}
+
+func TestUpdateFindsRowAfterRowsInsertedAbove(t *testing.T) {
+	ctx := context.Background()
+	repo, fake := newTestRepository(t, 3)
+
+	// Rows inserted by hand above the data, as when someone edits the spreadsheet
+	fake.mu.Lock()
+	rows := fake.sheets[postsSheetName]
+	fake.sheets[postsSheetName] = append([][]string{rows[0], {"", "", "note added by hand"}, {}}, rows[1:]...)
+	fake.mu.Unlock()
+
+	if err := repo.UpdatePost(ctx, &models.Post{ID: 2, Content: "post 2", Status: models.PostStatusScheduled}); err != nil {
+		t.Fatalf("UpdatePost: %v", err)
+	}
+
+	checkRows(t, fake, "")
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	if note := fake.sheets[postsSheetName][1]; len(note) < 3 || note[2] != "note added by hand" {
+		t.Errorf("hand-inserted row was overwritten: %v", note)
+	}
+}
+
+func TestAddAIUsageSumsPerDay(t *testing.T) {
+	ctx := context.Background()
+	repo, _ := newTestRepository(t, 0)
+
+	for _, day := range []string{"2026-10-15", "2026-10-16", "2026-10-16"} {
+		if err := repo.AddAIUsage(ctx, &models.AIUsage{Day: day, Requests: 1, InputTokens: 100, OutputTokens: 20}); err != nil {
+			t.Fatalf("AddAIUsage: %v", err)
+		}
+	}
+
+	usage, err := repo.GetAIUsage(ctx, "2026-10-16")
+	if err != nil {
+		t.Fatalf("GetAIUsage: %v", err)
+	}
+	if usage.Requests != 2 || usage.InputTokens != 200 || usage.OutputTokens != 40 {
+		t.Errorf("usage = %d requests, %d/%d tokens; want 2 requests, 200/40 tokens", usage.Requests, usage.InputTokens, usage.OutputTokens)
+	}
+	if usage, _ := repo.GetAIUsage(ctx, "2026-10-14"); usage == nil || usage.Requests != 0 {
+		t.Errorf("usage of a day without calls = %+v, want zero counts", usage)
+	}
+}
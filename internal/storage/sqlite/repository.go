@@ -9,13 +9,15 @@ import (
 
 	"github.com/glebarez/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 
 	"github.com/linkedin-agent/internal/models"
 	"github.com/linkedin-agent/internal/storage"
 )
 
-// Repository implements storage.Repository using SQLite
+// Repository implements storage.Repository using GORM. It backs both the SQLite and the
+// PostgreSQL storage (see the postgres package).
 type Repository struct {
 	db *gorm.DB
 }
@@ -30,7 +32,12 @@ func New(dsn string) (*Repository, error) {
 		}
 	}
 
-	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+	return Open(sqlite.Open(dsn))
+}
+
+// Open creates a repository on any GORM dialector
+func Open(dialector gorm.Dialector) (*Repository, error) {
+	db, err := gorm.Open(dialector, &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Silent),
 	})
 	if err != nil {
@@ -49,6 +56,8 @@ func (r *Repository) Migrate() error {
 		&models.SourceConfig{},
 		&models.Schedule{},
 		&models.Comment{},
+		&models.ExcludedAuthor{},
+		&models.AIUsage{},
 	)
 }
 
@@ -135,11 +144,11 @@ func (r *Repository) ListTopics(ctx context.Context, filter storage.TopicFilter)
 	return topics, nil
 }
 
-func (r *Repository) GetTopTopics(ctx context.Context, limit int, minScore float64) ([]*models.Topic, error) {
+func (r *Repository) GetTopTopics(ctx context.Context, limit int, minScore float64, statuses []models.TopicStatus) ([]*models.Topic, error) {
 	var topics []*models.Topic
-	// Get top topics by score that are approved and not yet used
+	// Get top topics by score among the requested statuses
 	if err := r.db.WithContext(ctx).
-		Where("status = ? AND ai_score >= ?", models.TopicStatusApproved, minScore).
+		Where("status IN ? AND ai_score >= ?", statuses, minScore).
 		Order("ai_score DESC").
 		Limit(limit).
 		Find(&topics).Error; err != nil {
@@ -156,6 +165,17 @@ func (r *Repository) DeleteTopic(ctx context.Context, id uint) error {
 	return r.db.WithContext(ctx).Delete(&models.Topic{}, id).Error
 }
 
+func (r *Repository) DeleteTopicsOlderThan(ctx context.Context, cutoff time.Time, statuses []models.TopicStatus) (int, error) {
+	result := r.db.WithContext(ctx).
+		Where("discovered_at < ? AND status IN ?", cutoff, statuses).
+		Where("id NOT IN (?)", r.db.Model(&models.Post{}).Select("topic_id").Where("topic_id IS NOT NULL")).
+		Delete(&models.Topic{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return int(result.RowsAffected), nil
+}
+
 // Post operations
 
 func (r *Repository) CreatePost(ctx context.Context, post *models.Post) error {
@@ -226,6 +246,25 @@ func (r *Repository) GetScheduledPosts(ctx context.Context, before time.Time) ([
 	return posts, nil
 }
 
+func (r *Repository) GetRecentHooks(ctx context.Context, n int) ([]string, error) {
+	var posts []*models.Post
+	if err := r.db.WithContext(ctx).
+		Select("ai_metadata").
+		Order("created_at DESC").
+		Limit(n).
+		Find(&posts).Error; err != nil {
+		return nil, err
+	}
+
+	hooks := make([]string, 0, len(posts))
+	for _, p := range posts {
+		if hook, ok := p.AIMetadata["hook"].(string); ok && hook != "" {
+			hooks = append(hooks, hook)
+		}
+	}
+	return hooks, nil
+}
+
 // OAuth token operations
 
 func (r *Repository) SaveToken(ctx context.Context, token *models.OAuthToken) error {
@@ -283,9 +322,21 @@ func (r *Repository) CreateComment(ctx context.Context, comment *models.Comment)
 	return r.db.WithContext(ctx).Create(comment).Error
 }
 
+func (r *Repository) GetCommentByID(ctx context.Context, id uint) (*models.Comment, error) {
+	var comment models.Comment
+	if err := r.db.WithContext(ctx).First(&comment, id).Error; err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
 func (r *Repository) GetCommentByTargetURN(ctx context.Context, targetURN string) (*models.Comment, error) {
 	var comment models.Comment
-	if err := r.db.WithContext(ctx).Where("target_post_urn = ?", targetURN).First(&comment).Error; err != nil {
+	err := r.db.WithContext(ctx).Where("target_post_urn = ?", targetURN).First(&comment).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
 		return nil, err
 	}
 	return &comment, nil
@@ -328,18 +379,20 @@ func (r *Repository) UpdateComment(ctx context.Context, comment *models.Comment)
 	return r.db.WithContext(ctx).Save(comment).Error
 }
 
-func (r *Repository) GetTodayCommentCount(ctx context.Context) (int, error) {
+func (r *Repository) CountCommentsSince(ctx context.Context, since time.Time) (int, error) {
 	var count int64
-	today := time.Now().Truncate(24 * time.Hour)
+	// SQLite compares times as text, so match the offset created_at is stored with
+	since = since.Local()
 	if err := r.db.WithContext(ctx).Model(&models.Comment{}).
-		Where("status = ? AND created_at >= ?", models.CommentStatusPosted, today).
+		// Pending comments (e.g. from dry runs) count too, so they can't pile up without limit
+		Where("status IN ? AND created_at >= ?", []models.CommentStatus{models.CommentStatusPosted, models.CommentStatusPending}, since).
 		Count(&count).Error; err != nil {
 		return 0, err
 	}
 	return int(count), nil
 }
 
-func (r *Repository) GetLastCommentTime(ctx context.Context) (*time.Time, error) {
+func (r *Repository) GetLastPostedComment(ctx context.Context) (*models.Comment, error) {
 	var comment models.Comment
 	if err := r.db.WithContext(ctx).
 		Where("status = ?", models.CommentStatusPosted).
@@ -347,7 +400,7 @@ func (r *Repository) GetLastCommentTime(ctx context.Context) (*time.Time, error)
 		First(&comment).Error; err != nil {
 		return nil, err
 	}
-	return comment.PostedAt, nil
+	return &comment, nil
 }
 
 func (r *Repository) GetRecentCommentStyles(ctx context.Context, limit int) ([]string, error) {
@@ -368,3 +421,53 @@ func (r *Repository) GetRecentCommentStyles(ctx context.Context, limit int) ([]s
 	}
 	return styles, nil
 }
+
+// Excluded author operations
+
+func (r *Repository) AddExcludedAuthor(ctx context.Context, author *models.ExcludedAuthor) error {
+	// Upsert - update reason if already excluded
+	var existing models.ExcludedAuthor
+	if err := r.db.WithContext(ctx).Where("author = ?", author.Author).First(&existing).Error; err == nil {
+		author.ID = existing.ID
+	}
+	return r.db.WithContext(ctx).Save(author).Error
+}
+
+func (r *Repository) ListExcludedAuthors(ctx context.Context) ([]*models.ExcludedAuthor, error) {
+	var authors []*models.ExcludedAuthor
+	if err := r.db.WithContext(ctx).Order("created_at DESC").Find(&authors).Error; err != nil {
+		return nil, err
+	}
+	return authors, nil
+}
+
+func (r *Repository) RemoveExcludedAuthor(ctx context.Context, author string) error {
+	return r.db.WithContext(ctx).Where("author = ?", author).Delete(&models.ExcludedAuthor{}).Error
+}
+
+// AI usage operations
+
+func (r *Repository) AddAIUsage(ctx context.Context, usage *models.AIUsage) error {
+	// A single upsert, so concurrent processes can't lose each other's counts
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "day"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"requests":      gorm.Expr("requests + excluded.requests"),
+			"input_tokens":  gorm.Expr("input_tokens + excluded.input_tokens"),
+			"output_tokens": gorm.Expr("output_tokens + excluded.output_tokens"),
+			"updated_at":    gorm.Expr("excluded.updated_at"),
+		}),
+	}).Create(usage).Error
+}
+
+func (r *Repository) GetAIUsage(ctx context.Context, day string) (*models.AIUsage, error) {
+	var usage models.AIUsage
+	err := r.db.WithContext(ctx).Where("day = ?", day).First(&usage).Error
+	if err == gorm.ErrRecordNotFound {
+		return &models.AIUsage{Day: day}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &usage, nil
+}
@@ -3,13 +3,16 @@ package tracker
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/api/option"
 	"google.golang.org/api/sheets/v4"
 
 	"github.com/linkedin-agent/internal/models"
+	"github.com/linkedin-agent/internal/storage"
 	"github.com/linkedin-agent/pkg/logger"
+	"github.com/linkedin-agent/pkg/sheetrange"
 )
 
 // SheetColumns defines the column headers for the Posts tracking sheet
@@ -29,6 +32,10 @@ var SheetColumns = []string{
 	"Error",
 	"Created At",
 	"Updated At",
+	"Poll Results",
+	"Likes",
+	"Comments",
+	"Shares",
 }
 
 // TopicsSheetColumns defines the column headers for the Topics sheet
@@ -45,6 +52,7 @@ var TopicsSheetColumns = []string{
 	"Use for Post?",
 	"Status",
 	"Discovered At",
+	"Suggested Hashtags",
 }
 
 // PostStatus represents the status of a tracked post
@@ -75,6 +83,9 @@ type TrackedPost struct {
 	Error         string
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	Likes         int
+	Comments      int
+	Shares        int
 }
 
 // SheetsTracker handles Google Sheets integration for post tracking
@@ -140,14 +151,14 @@ func (t *SheetsTracker) InitializeSheet(ctx context.Context) error {
 	}
 
 	// Check if headers exist
-	readRange := fmt.Sprintf("%s!A1:O1", t.sheetName)
+	readRange := sheetrange.Header(t.sheetName, len(SheetColumns))
 	resp, err := t.service.Spreadsheets.Values.Get(t.spreadsheetID, readRange).Context(ctx).Do()
 	if err != nil {
 		return fmt.Errorf("failed to read sheet: %w", err)
 	}
 
-	// If no data, add headers
-	if len(resp.Values) == 0 {
+	// If no data, add headers (or extend them when new columns were added)
+	if len(resp.Values) == 0 || len(resp.Values[0]) < len(SheetColumns) {
 		t.log.Info().Msg("Initializing sheet with headers")
 		return t.writeHeaders(ctx)
 	}
@@ -314,6 +325,38 @@ func (t *SheetsTracker) UpdatePostPublished(ctx context.Context, topicID uint, l
 	return t.updateCells(ctx, rowNum, updates)
 }
 
+// UpdatePollResults records the final vote distribution of a closed poll
+func (t *SheetsTracker) UpdatePollResults(ctx context.Context, topicID uint, results string) error {
+	rowNum, err := t.findRowByTopicID(ctx, topicID)
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]interface{}{
+		"O": time.Now().Format(time.RFC3339), // Updated At
+		"P": results,                         // Poll Results
+	}
+
+	return t.updateCells(ctx, rowNum, updates)
+}
+
+// UpdatePostEngagement records the latest likes, comments and shares of a published post
+func (t *SheetsTracker) UpdatePostEngagement(ctx context.Context, topicID uint, stats *models.PostStats) error {
+	rowNum, err := t.findRowByTopicID(ctx, topicID)
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]interface{}{
+		"O": time.Now().Format(time.RFC3339), // Updated At
+		"Q": stats.Likes,                     // Likes
+		"R": stats.Comments,                  // Comments
+		"S": stats.Shares,                    // Shares
+	}
+
+	return t.updateCells(ctx, rowNum, updates)
+}
+
 // UpdatePostFailed updates a post after a failed publish attempt
 func (t *SheetsTracker) UpdatePostFailed(ctx context.Context, topicID uint, errMsg string) error {
 	rowNum, err := t.findRowByTopicID(ctx, topicID)
@@ -418,7 +461,7 @@ func (t *SheetsTracker) appendRow(ctx context.Context, post *TrackedPost) error
 		post.UpdatedAt.Format(time.RFC3339),
 	}
 
-	appendRange := fmt.Sprintf("%s!A:O", t.sheetName)
+	appendRange := sheetrange.Columns(t.sheetName, len(SheetColumns))
 	valueRange := &sheets.ValueRange{
 		Values: [][]interface{}{row},
 	}
@@ -460,7 +503,7 @@ func (t *SheetsTracker) updateCells(ctx context.Context, rowNum int, updates map
 
 // GetAllPosts retrieves all tracked posts from the sheet
 func (t *SheetsTracker) GetAllPosts(ctx context.Context) ([]*TrackedPost, error) {
-	readRange := fmt.Sprintf("%s!A2:O", t.sheetName) // Skip header
+	readRange := sheetrange.Data(t.sheetName, len(SheetColumns))
 	resp, err := t.service.Spreadsheets.Values.Get(t.spreadsheetID, readRange).Context(ctx).Do()
 	if err != nil {
 		return nil, fmt.Errorf("failed to read posts: %w", err)
@@ -532,6 +575,9 @@ func parseRow(row []interface{}) *TrackedPost {
 		Error:         getString(12),
 		CreatedAt:     getTime(13),
 		UpdatedAt:     getTime(14),
+		Likes:         getInt(16),
+		Comments:      getInt(17),
+		Shares:        getInt(18),
 	}
 }
 
@@ -583,14 +629,14 @@ func (t *SheetsTracker) InitializeTopicsSheet(ctx context.Context) error {
 	}
 
 	// Check if headers exist
-	readRange := fmt.Sprintf("%s!A1:L1", topicsSheetName)
+	readRange := sheetrange.Header(topicsSheetName, len(TopicsSheetColumns))
 	resp, err := t.service.Spreadsheets.Values.Get(t.spreadsheetID, readRange).Context(ctx).Do()
 	if err != nil {
 		return fmt.Errorf("failed to read Topics sheet: %w", err)
 	}
 
-	// Add headers if empty
-	if len(resp.Values) == 0 {
+	// Add headers if empty (or extend them when new columns were added)
+	if len(resp.Values) == 0 || len(resp.Values[0]) < len(TopicsSheetColumns) {
 		var headerRow []interface{}
 		for _, col := range TopicsSheetColumns {
 			headerRow = append(headerRow, col)
@@ -649,6 +695,7 @@ func (t *SheetsTracker) SyncTopics(ctx context.Context, topics []*models.Topic)
 				"",                   // Use for Post? - empty for user
 				string(topic.Status),
 				topic.DiscoveredAt.Format(time.RFC3339),
+				topicHashtags(topic),
 			}
 			newRows = append(newRows, row)
 		}
@@ -659,7 +706,7 @@ func (t *SheetsTracker) SyncTopics(ctx context.Context, topics []*models.Topic)
 
 	// Batch append all new topics in a single API call
 	if len(newRows) > 0 {
-		appendRange := fmt.Sprintf("%s!A:L", topicsSheetName)
+		appendRange := sheetrange.Columns(topicsSheetName, len(TopicsSheetColumns))
 		valueRange := &sheets.ValueRange{
 			Values: newRows,
 		}
@@ -726,9 +773,10 @@ func (t *SheetsTracker) appendTopicRow(ctx context.Context, topic *models.Topic)
 		"",                                   // Use for Post? - empty for user
 		string(topic.Status),
 		topic.DiscoveredAt.Format(time.RFC3339),
+		topicHashtags(topic),
 	}
 
-	appendRange := fmt.Sprintf("%s!A:L", topicsSheetName)
+	appendRange := sheetrange.Columns(topicsSheetName, len(TopicsSheetColumns))
 	valueRange := &sheets.ValueRange{
 		Values: [][]interface{}{row},
 	}
@@ -742,6 +790,34 @@ func (t *SheetsTracker) appendTopicRow(ctx context.Context, topic *models.Topic)
 	return err
 }
 
+// topicHashtags formats the AI-suggested hashtags stored in the topic's RawData
+func topicHashtags(topic *models.Topic) string {
+	var tags []string
+	switch v := topic.RawData["hashtags"].(type) {
+	case []string:
+		tags = v
+	case []interface{}:
+		for _, t := range v {
+			if s, ok := t.(string); ok {
+				tags = append(tags, s)
+			}
+		}
+	}
+
+	formatted := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if !strings.HasPrefix(tag, "#") {
+			tag = "#" + tag
+		}
+		formatted = append(formatted, tag)
+	}
+	return strings.Join(formatted, " ")
+}
+
 // updateTopicRow updates an existing topic's status and score
 func (t *SheetsTracker) updateTopicRow(ctx context.Context, topic *models.Topic) error {
 	existingIDs, err := t.getExistingTopicIDs(ctx)
@@ -783,7 +859,7 @@ func (t *SheetsTracker) updateTopicRow(ctx context.Context, topic *models.Topic)
 
 // GetTopicsFromSheet reads all topics from the Topics sheet
 func (t *SheetsTracker) GetTopicsFromSheet(ctx context.Context) ([]map[string]interface{}, error) {
-	readRange := fmt.Sprintf("%s!A2:L", topicsSheetName)
+	readRange := sheetrange.Data(topicsSheetName, len(TopicsSheetColumns))
 	resp, err := t.service.Spreadsheets.Values.Get(t.spreadsheetID, readRange).Context(ctx).Do()
 	if err != nil {
 		return nil, fmt.Errorf("failed to read topics: %w", err)
@@ -813,6 +889,69 @@ func (t *SheetsTracker) GetTopicsFromSheet(ctx context.Context) ([]map[string]in
 	return topics, nil
 }
 
+// ImportUserDecisions reads the "Use for Post?" column of the Topics sheet and approves
+// the matching database topics for rows marked yes. Topics that are already approved or
+// used are left alone. Returns how many topics were approved and how many marked rows were skipped.
+func (t *SheetsTracker) ImportUserDecisions(ctx context.Context, repo storage.Repository) (int, int, error) {
+	rows, err := t.GetTopicsFromSheet(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	approved := 0
+	skipped := 0
+
+	for _, row := range rows {
+		if !isYes(row["use_for_post"].(string)) {
+			continue
+		}
+
+		var id uint
+		fmt.Sscanf(fmt.Sprintf("%v", row["id"]), "%d", &id)
+		if id == 0 {
+			skipped++
+			continue
+		}
+
+		topic, err := repo.GetTopicByID(ctx, id)
+		if err != nil {
+			t.log.Warn().Err(err).Uint("topic_id", id).Msg("Topic marked for posting not found in database")
+			skipped++
+			continue
+		}
+
+		if topic.Status == models.TopicStatusApproved || topic.Status == models.TopicStatusUsed {
+			skipped++
+			continue
+		}
+
+		topic.Status = models.TopicStatusApproved
+		if err := repo.UpdateTopic(ctx, topic); err != nil {
+			t.log.Warn().Err(err).Uint("topic_id", id).Msg("Failed to approve topic")
+			skipped++
+			continue
+		}
+
+		// Reflect the new status in the sheet
+		if err := t.updateTopicRow(ctx, topic); err != nil {
+			t.log.Warn().Err(err).Uint("topic_id", id).Msg("Failed to update topic status in sheet")
+		}
+		approved++
+	}
+
+	t.log.Info().Int("approved", approved).Int("skipped", skipped).Msg("Imported user decisions from sheet")
+	return approved, skipped, nil
+}
+
+// isYes reports whether a "Use for Post?" cell means yes
+func isYes(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "yes", "y", "true", "x", "1":
+		return true
+	}
+	return false
+}
+
 func safeString(row []interface{}, i int) string {
 	if i < len(row) {
 		return fmt.Sprintf("%v", row[i])
@@ -0,0 +1,36 @@
+// Package sheetrange builds A1-notation ranges for Google Sheets, sized by column count so
+// ranges grow with a sheet's schema instead of stopping at a hardcoded last column.
+package sheetrange
+
+import "fmt"
+
+// Header returns the header row of a sheet with width columns, e.g. "Posts!A1:S1"
+func Header(sheetName string, width int) string {
+	return fmt.Sprintf("%s!A1:%s1", sheetName, ColumnLetter(width))
+}
+
+// Data returns every row below the header, e.g. "Posts!A2:S"
+func Data(sheetName string, width int) string {
+	return fmt.Sprintf("%s!A2:%s", sheetName, ColumnLetter(width))
+}
+
+// Columns returns a range spanning all the columns, used as the target of appends, e.g. "Posts!A:S"
+func Columns(sheetName string, width int) string {
+	return fmt.Sprintf("%s!A:%s", sheetName, ColumnLetter(width))
+}
+
+// Row returns a single 1-based row, e.g. "Posts!A5:S5"
+func Row(sheetName string, row, width int) string {
+	return fmt.Sprintf("%s!A%d:%s%d", sheetName, row, ColumnLetter(width), row)
+}
+
+// ColumnLetter converts a 1-based column index to Excel-style letter (1=A, 26=Z, 27=AA)
+func ColumnLetter(n int) string {
+	result := ""
+	for n > 0 {
+		n-- // Adjust for 0-based indexing
+		result = string(rune('A'+n%26)) + result
+		n /= 26
+	}
+	return result
+}
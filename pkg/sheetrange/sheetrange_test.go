@@ -0,0 +1,25 @@
+package sheetrange
+
+import "testing"
+
+func TestColumnLetter(t *testing.T) {
+	for n, want := range map[int]string{1: "A", 19: "S", 26: "Z", 27: "AA", 52: "AZ", 703: "AAA"} {
+		if got := ColumnLetter(n); got != want {
+			t.Errorf("ColumnLetter(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestRanges(t *testing.T) {
+	tests := []struct{ got, want string }{
+		{Header("Posts", 19), "Posts!A1:S1"},
+		{Data("Posts", 19), "Posts!A2:S"},
+		{Columns("Posts", 19), "Posts!A:S"},
+		{Row("Posts", 5, 19), "Posts!A5:S5"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %q, want %q", tt.got, tt.want)
+		}
+	}
+}